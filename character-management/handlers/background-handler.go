@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	defaultjsonconfigs "github.com/onioncall/dndgo/character-management/default-json-configs"
+	"github.com/onioncall/dndgo/character-management/models"
+)
+
+const backgroundsFile = "backgrounds.json"
+
+// Loads the SRD backgrounds along with any the user has defined in backgrounds.json in the config directory.
+// A user background with the same name as an SRD one replaces it
+func LoadBackgrounds() (map[string]models.Background, error) {
+	fileData, err := defaultjsonconfigs.Content.ReadFile(backgroundsFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read template backgrounds file: %w", err)
+	}
+
+	var backgrounds []models.Background
+	if err := json.Unmarshal(fileData, &backgrounds); err != nil {
+		return nil, fmt.Errorf("Failed to parse template backgrounds: %w", err)
+	}
+
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get config path for backgrounds:\n%w", err)
+	}
+
+	userData, err := os.ReadFile(filepath.Join(configPath, backgroundsFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("Failed to read user backgrounds file: %w", err)
+	}
+
+	if len(userData) > 0 {
+		var userBackgrounds []models.Background
+		if err := json.Unmarshal(userData, &userBackgrounds); err != nil {
+			return nil, fmt.Errorf("Failed to parse user backgrounds: %w", err)
+		}
+
+		backgrounds = append(backgrounds, userBackgrounds...)
+	}
+
+	backgroundMap := make(map[string]models.Background, len(backgrounds))
+	for _, b := range backgrounds {
+		backgroundMap[strings.ToLower(b.Name)] = b
+	}
+
+	return backgroundMap, nil
+}
+
+// Returns nil without an error when the background isn't one we have a definition for
+func GetBackground(name string) (*models.Background, error) {
+	backgrounds, err := LoadBackgrounds()
+	if err != nil {
+		return nil, err
+	}
+
+	b, ok := backgrounds[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, nil
+	}
+
+	return &b, nil
+}
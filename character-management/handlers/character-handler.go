@@ -5,21 +5,23 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 
 	"github.com/onioncall/dndgo/character-management/db"
 	defaultjsonconfigs "github.com/onioncall/dndgo/character-management/default-json-configs"
 	"github.com/onioncall/dndgo/character-management/models"
 	"github.com/onioncall/dndgo/character-management/shared"
+	"github.com/onioncall/dndgo/logger"
 	"github.com/onioncall/dndgo/search/handlers"
 )
 
 func HandleCharacter(c *models.Character) error {
+	if race := c.GetRace(); race != nil {
+		race.ExecutePreCalculateMethods(c)
+	}
+
 	for i := range c.Classes {
 		if c.Classes[i] != nil {
-			c.HitDice = c.Classes[i].CalculateHitDice()
-
 			if preCalculater, ok := c.Classes[i].(models.PreCalculator); ok {
 				preCalculater.ExecutePreCalculateMethods(c)
 			}
@@ -49,7 +51,36 @@ func BuildCharacterMarkdown(c models.Character) error {
 	return nil
 }
 
-func AddSpell(c *models.Character, spellQuery string) error {
+func AddSpell(c *models.Character, spellQuery string, classType string) error {
+	cs, err := getCharacterSpell(spellQuery)
+	if err != nil {
+		return fmt.Errorf("Failed To get spell (%s) to add: %w", spellQuery, err)
+	}
+
+	err = c.AddSpell(cs, classType)
+	if err != nil {
+		return fmt.Errorf("Failed to add spell '%s':\n%w", cs.Name, err)
+	}
+
+	return nil
+}
+
+// Replaces a spell known by the class with a new one, allowed once each time a known spell class levels up
+func SwapSpell(c *models.Character, oldSpell string, spellQuery string, classType string) error {
+	cs, err := getCharacterSpell(spellQuery)
+	if err != nil {
+		return fmt.Errorf("Failed To get spell (%s) to swap in: %w", spellQuery, err)
+	}
+
+	err = c.SwapKnownSpell(oldSpell, cs, classType)
+	if err != nil {
+		return fmt.Errorf("Failed to swap spell '%s' for '%s':\n%w", oldSpell, cs.Name, err)
+	}
+
+	return nil
+}
+
+func getCharacterSpell(spellQuery string) (shared.CharacterSpell, error) {
 	r := handlers.SpellRequest{
 		Name:     spellQuery,
 		PathType: handlers.SpellType,
@@ -57,23 +88,59 @@ func AddSpell(c *models.Character, spellQuery string) error {
 
 	s, err := r.GetSingle()
 	if err != nil {
-		return fmt.Errorf("Failed To get spell (%s) to add: %w", spellQuery, err)
+		return shared.CharacterSpell{}, err
 	}
 
-	cs := shared.CharacterSpell{
-		SlotLevel: s.Level,
-		IsRitual:  s.Ritual,
-		Name:      s.Name,
+	return shared.CharacterSpell{
+		SlotLevel:       s.Level,
+		IsRitual:        s.Ritual,
+		Name:            s.Name,
+		IsConcentration: s.Concentration,
+		Duration:        s.Duration,
+	}, nil
+}
+
+// Casts a known spell on the character. Spells added before concentration was tracked have no duration, so
+// we look those up once to fill in whether they require concentration
+func CastSpell(c *models.Character, spellName string, slotLevel int, pact bool, metamagic string) (string, error) {
+	for i, cs := range c.Spells {
+		if !strings.EqualFold(cs.Name, spellName) || cs.Duration != "" {
+			continue
+		}
+
+		r := handlers.SpellRequest{
+			Name:     cs.Name,
+			PathType: handlers.SpellType,
+		}
+
+		s, err := r.GetSingle()
+		if err != nil {
+			logger.Info(fmt.Sprintf("Unable to look up concentration for spell '%s': %s", cs.Name, err))
+			break
+		}
+
+		c.Spells[i].IsConcentration = s.Concentration
+		c.Spells[i].Duration = s.Duration
 	}
 
-	c.Spells = append(c.Spells, cs)
+	return c.CastSpell(spellName, slotLevel, pact, metamagic)
+}
+
+// Looks up the weight of an item in the SRD. Plenty of items (like magic items or anything homebrew)
+// won't be found, so those are logged and given no weight
+func GetEquipmentWeight(name string) float64 {
+	r := handlers.EquipmentRequest{
+		Name:     strings.ToLower(name),
+		PathType: handlers.EquipmentType,
+	}
 
-	// sorting spells by level
-	sort.Slice(c.Spells, func(i, j int) bool {
-		return c.Spells[i].SlotLevel < c.Spells[j].SlotLevel
-	})
+	e, err := r.GetSingle()
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to look up weight for item '%s': %s", name, err))
+		return 0
+	}
 
-	return nil
+	return e.Weight
 }
 
 func GetConfigPath() (string, error) {
@@ -103,6 +170,20 @@ func CreateCharacter(c *models.Character) error {
 		c.Default = true
 	}
 
+	if c.Background != "" {
+		b, err := GetBackground(c.Background)
+		if err != nil {
+			return fmt.Errorf("Failed to load background '%s':\n%w", c.Background, err)
+		}
+
+		// Free text backgrounds are kept as they are
+		if b != nil {
+			c.ApplyBackground(*b)
+		} else {
+			logger.Info(fmt.Sprintf("No definition for background '%s', nothing was granted", c.Background))
+		}
+	}
+
 	cid, err := db.Repo.InsertCharacter(*c)
 	if err != nil {
 		return fmt.Errorf("Failed to insert new character: %w", err)
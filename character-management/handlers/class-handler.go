@@ -91,6 +91,52 @@ func LoadClassTemplate(classType string) (models.Class, error) {
 	return c, nil
 }
 
+// Multiclasses the character into a new class and levels it up to 1. The class is only inserted once the
+// level up succeeds, so a character that can't level up isn't left with a level 0 class
+func AddClass(c *models.Character, classType string, rollHP bool) (models.LevelUpResult, error) {
+	classType = strings.ToLower(classType)
+	for _, ct := range c.ClassTypes {
+		if strings.EqualFold(ct, classType) {
+			return models.LevelUpResult{}, fmt.Errorf("Character already has class '%s'", classType)
+		}
+	}
+
+	class, err := LoadClassTemplate(classType)
+	if err != nil {
+		return models.LevelUpResult{}, fmt.Errorf("Failed to load class template for type '%s':\n%w", classType, err)
+	}
+
+	class.SetCharacterId(c.ID)
+	class.SetClassType(classType)
+
+	classes, classTypes := c.Classes, c.ClassTypes
+	c.Classes = append(slices.Clone(classes), class)
+	c.ClassTypes = append(slices.Clone(classTypes), classType)
+
+	result, err := c.LevelUp(classType, rollHP)
+	if err != nil {
+		c.Classes, c.ClassTypes = classes, classTypes
+		return models.LevelUpResult{}, err
+	}
+
+	err = db.Repo.InsertClass(class)
+	if err != nil {
+		c.Classes, c.ClassTypes = classes, classTypes
+		return models.LevelUpResult{}, fmt.Errorf("Failed to add class '%s':\n%w", classType, err)
+	}
+
+	return result, nil
+}
+
+// Levels up the given class, multiclassing into it first if the character doesn't have it yet
+func LevelUp(c *models.Character, classType string, rollHP bool) (models.LevelUpResult, error) {
+	if !slices.ContainsFunc(c.ClassTypes, func(ct string) bool { return strings.EqualFold(ct, classType) }) {
+		return AddClass(c, classType, rollHP)
+	}
+
+	return c.LevelUp(classType, rollHP)
+}
+
 func SaveClass(c models.Class) error {
 	return db.Repo.SyncClass(c)
 }
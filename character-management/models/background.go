@@ -0,0 +1,96 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/onioncall/dndgo/character-management/shared"
+)
+
+// Backgrounds are applied once when a character is created, everything they grant is saved to the character
+type Background struct {
+	Name               string                `json:"name"`
+	SkillProficiencies []string              `json:"skill-proficiencies"`
+	ToolProficiencies  []string              `json:"tool-proficiencies"`
+	Languages          []string              `json:"languages"`
+	Equipment          []shared.BackpackItem `json:"equipment"`
+	Purse              shared.Purse          `json:"purse"`
+	Feature            GenericItem           `json:"feature"`
+}
+
+// Grants the proficiencies, languages, equipment and feature of a background. Proficiencies the character
+// already has are returned and kept on the character, so a replacement can be chosen for each of them
+func (c *Character) ApplyBackground(b Background) []string {
+	c.Background = b.Name
+	c.BackgroundFeature = b.Feature
+
+	duplicates := []string{}
+	for _, skill := range b.SkillProficiencies {
+		i := slices.IndexFunc(c.Skills, func(s shared.Skill) bool { return strings.EqualFold(s.Name, skill) })
+		if i == -1 {
+			continue
+		}
+
+		if c.Skills[i].Proficient {
+			duplicates = append(duplicates, strings.ToLower(skill))
+			continue
+		}
+
+		c.Skills[i].Proficient = true
+	}
+
+	for _, tool := range b.ToolProficiencies {
+		if c.hasToolProficiency(tool) {
+			duplicates = append(duplicates, strings.ToLower(tool))
+			continue
+		}
+
+		c.ToolProficiencies = append(c.ToolProficiencies, strings.ToLower(tool))
+	}
+
+	for _, lang := range b.Languages {
+		if !slices.ContainsFunc(c.Languages, func(l string) bool { return strings.EqualFold(l, lang) }) {
+			c.AddLanguage(lang)
+		}
+	}
+
+	for _, item := range b.Equipment {
+		c.AddItemToPack(item.Name, item.Quantity, item.Weight)
+	}
+	c.AddCurrency(b.Purse)
+
+	c.BackgroundDuplicates = append(c.BackgroundDuplicates, duplicates...)
+
+	return duplicates
+}
+
+// Replaces a proficiency the background granted twice with a skill or tool of the characters choice
+func (c *Character) ReplaceBackgroundDuplicate(duplicate string, replacement string) error {
+	idx := slices.IndexFunc(c.BackgroundDuplicates, func(d string) bool { return strings.EqualFold(d, duplicate) })
+	if idx == -1 {
+		return fmt.Errorf("Proficiency '%s' is not a background duplicate", duplicate)
+	}
+
+	if i := slices.IndexFunc(c.Skills, func(s shared.Skill) bool { return strings.EqualFold(s.Name, replacement) }); i != -1 {
+		if c.Skills[i].Proficient {
+			return fmt.Errorf("Character is already proficient in '%s'", replacement)
+		}
+
+		c.Skills[i].Proficient = true
+	} else {
+		if c.hasToolProficiency(replacement) {
+			return fmt.Errorf("Character is already proficient with '%s'", replacement)
+		}
+
+		c.ToolProficiencies = append(c.ToolProficiencies, strings.ToLower(replacement))
+	}
+
+	c.BackgroundDuplicates = slices.Delete(c.BackgroundDuplicates, idx, idx+1)
+
+	return nil
+}
+
+func (c *Character) hasToolProficiency(tool string) bool {
+	return slices.ContainsFunc(c.ToolProficiencies, func(t string) bool { return strings.EqualFold(t, tool) })
+}
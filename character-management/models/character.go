@@ -2,9 +2,13 @@ package models
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/onioncall/dndgo/character-management/shared"
+	"github.com/onioncall/dndgo/dice"
 	"github.com/onioncall/dndgo/logger"
 )
 
@@ -17,32 +21,62 @@ type Character struct {
 	Name                    string                               `json:"name" clover:"name"`
 	ShortName               string                               `json:"short-name" clover:"short-name"`
 	Level                   int                                  `json:"-" clover:"-"`
+	XP                      int                                  `json:"xp" clover:"xp"`
+	MilestoneLeveling       bool                                 `json:"milestone-leveling" clover:"milestone-leveling"`
 	Race                    string                               `json:"race" clover:"race"`
+	Subrace                 string                               `json:"subrace" clover:"subrace"`
+	RacialBonusMode         string                               `json:"racial-bonus-mode" clover:"racial-bonus-mode"`
+	RacialAbilityBonuses    []shared.AbilityScoreImprovementItem `json:"racial-ability-bonuses" clover:"racial-ability-bonuses"`
+	RaceTraits              []shared.RaceTrait                   `json:"-" clover:"-"`
+	Darkvision              int                                  `json:"-" clover:"-"`
+	Resistances             []string                             `json:"-" clover:"-"`
 	Background              string                               `json:"background" clover:"background"`
-	Feats                   []GenericItem                        `json:"feats" clover:"feats"`
+	BackgroundFeature       GenericItem                          `json:"background-feature" clover:"background-feature"`
+	BackgroundDuplicates    []string                             `json:"background-duplicates" clover:"background-duplicates"`
+	ToolProficiencies       []string                             `json:"tool-proficiencies" clover:"tool-proficiencies"`
+	Feats                   []Feat                               `json:"feats" clover:"feats"`
 	Languages               []string                             `json:"languages" clover:"languages"`
 	Proficiency             int                                  `json:"-" clover:"-"`
 	PassivePerception       int                                  `json:"-" clover:"-"`
 	PassiveInsight          int                                  `json:"-" clover:"-"`
+	PassiveInvestigation    int                                  `json:"-" clover:"-"`
+	Initiative              int                                  `json:"-" clover:"-"`
 	AC                      int                                  `json:"-" clover:"-"`
 	HPCurrent               int                                  `json:"hp-current" clover:"hp-current"`
 	HPMax                   int                                  `json:"hp-max" clover:"hp-max"`
+	HPMaxAdjusted           int                                  `json:"-" clover:"-"`
 	HPTemp                  int                                  `json:"hp-temp" clover:"hp-temp"`
+	DeathSaves              shared.DeathSaves                    `json:"death-saves" clover:"death-saves"`
 	Speed                   int                                  `json:"speed" clover:"speed"`
+	SpeedAdjusted           int                                  `json:"-" clover:"-"`
+	Conditions              []string                             `json:"conditions" clover:"conditions"`
+	Exhaustion              int                                  `json:"exhaustion" clover:"exhaustion"`
 	HitDice                 string                               `json:"-" clover:"-"`
 	Abilities               []shared.Ability                     `json:"abilities" clover:"abilities"`
 	Skills                  []shared.Skill                       `json:"skills" clover:"skills"`
 	SpellSlots              []shared.SpellSlot                   `json:"spell-slots" clover:"spell-slots"`
 	SpellSaveDC             int                                  `json:"-" clover:"-"`
 	SpellAttackMod          int                                  `json:"-" clover:"-"`
+	Spellcasting            []SpellcastingStats                  `json:"-" clover:"-"`
 	Spells                  []shared.CharacterSpell              `json:"spells" clover:"spells"`
+	Concentration           string                               `json:"concentration" clover:"concentration"`
+	ConcentrationSaveDC     int                                  `json:"concentration-save-dc,omitempty" clover:"concentration-save-dc"`
 	Weapons                 []shared.Weapon                      `json:"weapons" clover:"weapons"`
 	PrimaryEquipped         string                               `json:"primary-equipped" clover:"primary-equipped"`
 	SecondaryEquipped       string                               `json:"secondary-equipped" clover:"secondary-equipped"`
 	WornEquipment           shared.WornEquipment                 `json:"worn-equipment" clover:"worn-equipment"`
 	Backpack                []shared.BackpackItem                `json:"backpack" clover:"backpack"`
+	Purse                   shared.Purse                         `json:"purse" clover:"purse"`
+	VariantEncumbrance      bool                                 `json:"variant-encumbrance" clover:"variant-encumbrance"`
+	CarriedWeight           float64                              `json:"-" clover:"-"`
+	CarryingCapacity        int                                  `json:"-" clover:"-"`
 	AbilityScoreImprovement []shared.AbilityScoreImprovementItem `json:"ability-score-improvement" clover:"ability-score-improvement"`
 	Classes                 []Class                              `json:"-" clover:"-"`
+
+	speedBonus    int
+	raceSpeed     int
+	racialBonuses map[string]int
+	raceLanguages []string
 }
 
 type GenericItem struct {
@@ -50,6 +84,65 @@ type GenericItem struct {
 	Desc string `json:"description"`
 }
 
+type ConcentrationSaveResult struct {
+	Spell      string
+	DC         int
+	Roll       dice.Result
+	Maintained bool
+	Note       string
+	Lucky      bool
+}
+
+type DeathSaveResult struct {
+	Roll       dice.Result
+	Successes  int
+	Failures   int
+	Revived    bool
+	Stabilized bool
+	Dead       bool
+	Lucky      bool
+}
+
+type LevelUpResult struct {
+	ClassType               string
+	ClassLevel              int
+	CharacterLevel          int
+	HPRoll                  int
+	HPRolled                bool
+	HPGained                int
+	Features                []ClassFeature
+	SpellSlots              []shared.SpellSlot
+	AbilityScoreImprovement bool
+	SubClassChoice          bool
+	SpellSwap               bool
+}
+
+// Each spellcasting class has its own ability, so a multiclassed character has a save DC and attack modifier per class
+type SpellcastingStats struct {
+	ClassType      string
+	Ability        string
+	SpellSaveDC    int
+	SpellAttackMod int
+}
+
+// Prepared spells against the maximum for one class, shown as "prepared X/Y"
+type PreparedSpellCount struct {
+	ClassType string
+	Prepared  int
+	Maximum   int
+}
+
+type AttackResult struct {
+	Weapon       string
+	ToHit        dice.Result
+	Damage       dice.Result
+	Critical     bool
+	CriticalMiss bool
+	Note         string
+	Lucky        bool
+	Options      []string
+}
+
 var (
 	PreCalculateMethods  []func(c *Character)
 	PostCalculateMethods []func(c *Character)
@@ -60,15 +153,23 @@ var (
 // Derive character stats from the character/class data
 func (c *Character) CalculateCharacterStats() {
 	c.calculateCharacterLevel()
+	c.calculateHitDice()
+	c.calculateHPMax()
 	c.calculateProficiencyBonusByLevel()
 	c.calculateAdjustedAbilities()
 	c.calculateAbilityScoreImprovement()
+	c.calculateFeats()
+	c.calculateMagicItems()
 	c.calculateAbilitiesFromBase()
+	c.calculateInitiative()
+	c.calculateEncumbrance()
+	c.calculateSpeed()
 	c.calculateSkillModifierFromBase()
 	c.calculateAC()
 	c.calculatePassiveStats()
 	c.calculateWeaponBonus()
 	c.calculatePreparedSpells()
+	c.calculateSpellSlots()
 }
 
 func (c *Character) calculateCharacterLevel() {
@@ -77,15 +178,93 @@ func (c *Character) calculateCharacterLevel() {
 	}
 }
 
+// Hit dice are shown as available/total for each class, ex: '2/3d10, 1/1d8'
+func (c *Character) calculateHitDice() {
+	hitDice := []string{}
+	for _, class := range c.Classes {
+		if class == nil {
+			continue
+		}
+
+		hitDice = append(hitDice, fmt.Sprintf("%d/%s", class.GetHitDiceAvailable(), class.CalculateHitDice()))
+	}
+
+	c.HitDice = strings.Join(hitDice, ", ")
+}
+
+// Feats like Tough raise the maximum every level, and four or more levels of exhaustion halves it
+func (c *Character) calculateHPMax() {
+	c.HPMaxAdjusted = c.HPMax + c.getFeatBonus(func(f shared.FeatDefinition) int { return f.HPPerLevel })*c.Level
+
+	if c.Exhaustion >= 4 {
+		c.HPMaxAdjusted /= 2
+	}
+
+	if c.HPCurrent > c.HPMaxAdjusted {
+		c.HPCurrent = c.HPMaxAdjusted
+	}
+}
+
+// Speed bonuses from classes are added to SpeedAdjusted before stats are calculated. They're kept
+// separately so conditions can be reapplied to the total speed whenever they change
+func (c *Character) calculateSpeed() {
+	c.speedBonus = c.SpeedAdjusted + c.getFeatBonus(func(f shared.FeatDefinition) int { return f.Speed }) +
+		c.getMagicItemBonus(func(m shared.MagicItem) int { return m.Speed })
+	c.applySpeedConditions()
+}
+
+// A speed in the character file takes priority, otherwise the racial speed is used
+func (c *Character) applySpeedConditions() {
+	speed := c.Speed
+	if speed == 0 {
+		speed = c.raceSpeed
+	}
+	c.SpeedAdjusted = speed + c.speedBonus
+
+	if c.VariantEncumbrance {
+		c.SpeedAdjusted = max(c.SpeedAdjusted-shared.EncumbranceSpeedPenalty[c.GetEncumbrance()], 0)
+	}
+
+	switch {
+	case c.Exhaustion >= 5 || c.HasCondition(shared.ConditionRestrained):
+		c.SpeedAdjusted = 0
+	case c.Exhaustion >= 2:
+		c.SpeedAdjusted /= 2
+	}
+}
+
+func (c *Character) calculateEncumbrance() {
+	c.CarryingCapacity = 0
+	for _, a := range c.Abilities {
+		if strings.EqualFold(a.Name, shared.AbilityStrength) {
+			c.CarryingCapacity = a.Adjusted * shared.CarryingCapacityMultiplier
+		}
+	}
+
+	c.CarriedWeight = c.GetCarriedWeight()
+}
+
+// Items changing hands mid session should be reflected in speed right away, without waiting on a
+// full recalculation
+func (c *Character) updateEncumbrance() {
+	c.calculateEncumbrance()
+	c.applySpeedConditions()
+}
+
 func (c *Character) calculateAbilitiesFromBase() {
 	for i := range c.Abilities {
 		c.Abilities[i].AbilityModifier = (c.Abilities[i].Adjusted - 10) / 2
 	}
 }
 
+func (c *Character) calculateInitiative() {
+	c.Initiative = c.GetMod(shared.AbilityDexterity) + c.getFeatBonus(func(f shared.FeatDefinition) int { return f.Initiative })
+}
+
+// Racial bonuses are set by the race pre-calculate step, and are empty when the base scores already include them
 func (c *Character) calculateAdjustedAbilities() {
 	for i, a := range c.Abilities {
-		c.Abilities[i].Adjusted = a.Base
+		c.Abilities[i].Adjusted = min(20, a.Base+c.racialBonuses[strings.ToLower(a.Name)])
 	}
 }
 
@@ -126,6 +305,11 @@ func (c *Character) calculateAbilityScoreImprovement() {
 
 	bonusSum := 0
 	for _, item := range c.AbilityScoreImprovement {
+		if item.Feat != "" {
+			bonusSum += shared.AbilityScoreImprovementPoints
+			continue
+		}
+
 		bonusSum += item.Bonus
 	}
 
@@ -173,18 +357,30 @@ func (c *Character) calculateAC() {
 		c.AC = 10 + c.GetMod(shared.AbilityDexterity)
 	}
 
-	if c.WornEquipment.Shield != "" {
-		if strings.EqualFold(c.PrimaryEquipped, c.WornEquipment.Shield) ||
-			strings.EqualFold(c.SecondaryEquipped, c.WornEquipment.Shield) {
-			c.AC += 2
-		}
+	c.AC += c.GetShieldACBonus() + c.GetMagicItemACBonus()
+}
+
+// A shield only adds to AC while it's equipped in one of the character's hands
+func (c *Character) GetShieldACBonus() int {
+	if c.WornEquipment.Shield == "" {
+		return 0
+	}
+
+	if strings.EqualFold(c.PrimaryEquipped, c.WornEquipment.Shield) ||
+		strings.EqualFold(c.SecondaryEquipped, c.WornEquipment.Shield) {
+		return 2
 	}
+
+	return 0
 }
 
+// Observant adds to passive perception and investigation, but not insight
 func (c *Character) calculatePassiveStats() {
 	wisMod := c.GetMod(shared.AbilityWisdom)
-	c.PassivePerception = 10 + wisMod
+	featBonus := c.getFeatBonus(func(f shared.FeatDefinition) int { return f.PassiveBonus })
+	c.PassivePerception = 10 + wisMod + featBonus
 	c.PassiveInsight = 10 + wisMod
+	c.PassiveInvestigation = 10 + c.GetMod(shared.AbilityIntelligence) + featBonus
 
 	for _, skill := range c.Skills {
 		if strings.ToLower(skill.Name) == shared.SkillPerception {
@@ -195,38 +391,92 @@ func (c *Character) calculatePassiveStats() {
 			if skill.Proficient {
 				c.PassiveInsight += c.Proficiency
 			}
+		} else if strings.ToLower(skill.Name) == shared.SkillInvestigation {
+			if skill.Proficient {
+				c.PassiveInvestigation += c.Proficiency
+			}
 		}
 	}
 }
 
 func (c *Character) calculateWeaponBonus() {
+	offHand := c.GetOffHandWeaponIndex()
+
 	for i, weapon := range c.Weapons {
-		c.Weapons[i].Bonus = 0
-		dexMod := c.GetMod(shared.AbilityDexterity)
-		strMod := c.GetMod(shared.AbilityStrength)
-		modApplied := false
-
-		for _, prop := range weapon.Properties {
-			// We'll prioritize the weapon properties that have a choice between dex and str mods
-			prop = strings.ToLower(prop)
-			if prop == shared.WeaponPropertyFinesse || prop == shared.WeaponPropertyThrown {
-				c.Weapons[i].Bonus += max(dexMod, strMod)
-				modApplied = true
-			}
+		abilityMod := c.GetWeaponAbilityMod(weapon)
+
+		// Since custom weapons are sometimes a thing, we'll allow the user to specify a custom bonus.
+		// This applies to both attack and damage rolls, like a +1 weapon
+		c.Weapons[i].AttackBonus = abilityMod + weapon.CustomBonus
+		c.Weapons[i].DamageBonus = abilityMod + weapon.CustomBonus
+
+		// The off hand attack doesn't add the ability modifier to damage unless it's negative,
+		// the Two-Weapon Fighting style adds it back
+		if i == offHand {
+			c.Weapons[i].DamageBonus = min(abilityMod, 0) + weapon.CustomBonus
 		}
 
-		// If we haven't applied our mod from properties, we'll apply it based on range
-		if !modApplied {
-			if weapon.Ranged {
-				c.Weapons[i].Bonus += dexMod
-			} else {
-				c.Weapons[i].Bonus += strMod
-			}
+		// Proficiency only applies to the attack roll
+		if weapon.Proficient {
+			c.Weapons[i].AttackBonus += c.Proficiency
 		}
+	}
+}
+
+// Finesse and thrown weapons use the better of strength and dexterity, otherwise ranged weapons
+// use dexterity and melee weapons use strength
+func (c *Character) GetWeaponAbilityMod(weapon shared.Weapon) int {
+	dexMod := c.GetMod(shared.AbilityDexterity)
+	strMod := c.GetMod(shared.AbilityStrength)
+
+	for _, prop := range weapon.Properties {
+		prop = strings.ToLower(prop)
+		if prop == shared.WeaponPropertyFinesse || prop == shared.WeaponPropertyThrown {
+			return max(dexMod, strMod)
+		}
+	}
+
+	if weapon.Ranged {
+		return dexMod
+	}
+
+	return strMod
+}
+
+// When dual wielding, the weapon equipped as secondary is the off hand weapon. It must be one handed and
+// have the "light" property, and the primary weapon must be one handed. Like the weapon table, the first
+// weapon matching the primary name is the primary, so two of the same weapon can be dual wielded.
+// Returns -1 when the character isn't dual wielding
+func (c *Character) GetOffHandWeaponIndex() int {
+	if c.PrimaryEquipped == "" || c.SecondaryEquipped == "" {
+		return -1
+	}
+
+	primaryIdx := -1
+	offHandIdx := -1
+	for i, weapon := range c.Weapons {
+		if strings.EqualFold(c.PrimaryEquipped, weapon.Name) && primaryIdx == -1 {
+			primaryIdx = i
+		} else if strings.EqualFold(c.SecondaryEquipped, weapon.Name) && offHandIdx == -1 {
+			offHandIdx = i
+		}
+	}
 
-		// Since custom weapons are sometimes a thing, we'll allow the user to specify a custom bonus
-		c.Weapons[i].Bonus += weapon.CustomBonus
+	if primaryIdx == -1 || offHandIdx == -1 {
+		return -1
 	}
+
+	primary, offHand := c.Weapons[primaryIdx], c.Weapons[offHandIdx]
+	if hasWeaponProperty(primary, shared.WeaponPropertyTwoHanded) || hasWeaponProperty(offHand, shared.WeaponPropertyTwoHanded) ||
+		!hasWeaponProperty(offHand, shared.WeaponPropertyLight) {
+		return -1
+	}
+
+	return offHandIdx
+}
+
+func hasWeaponProperty(weapon shared.Weapon, property string) bool {
+	return slices.ContainsFunc(weapon.Properties, func(p string) bool { return strings.EqualFold(p, property) })
 }
 
 func (c *Character) calculatePreparedSpells() {
@@ -249,6 +499,130 @@ func (c *Character) calculatePreparedSpells() {
 	}
 }
 
+func (c *Character) GetPreparedSpellCounts() []PreparedSpellCount {
+	counts := []PreparedSpellCount{}
+
+	for _, class := range c.Classes {
+		if psClass, ok := class.(PreparedSpellClass); ok {
+			counts = append(counts, PreparedSpellCount{
+				ClassType: class.GetClassType(),
+				Prepared:  len(psClass.GetPreparedSpells()),
+				Maximum:   psClass.GetPreparedSpellsMax(),
+			})
+		}
+	}
+
+	return counts
+}
+
+// The class type is only included when more than one class prepares spells
+func (c *Character) GetPreparedSpellsSummary() string {
+	counts := c.GetPreparedSpellCounts()
+	summaries := []string{}
+
+	for _, count := range counts {
+		if len(counts) > 1 {
+			summaries = append(summaries, fmt.Sprintf("%s prepared %d/%d", count.ClassType, count.Prepared, count.Maximum))
+		} else {
+			summaries = append(summaries, fmt.Sprintf("Prepared %d/%d", count.Prepared, count.Maximum))
+		}
+	}
+
+	return strings.Join(summaries, ", ")
+}
+
+// Sets the spellcasting stats for a class. SpellSaveDC and SpellAttackMod on the character keep the best of them,
+// so they don't depend on which class was calculated last
+func (c *Character) SetSpellcasting(classType string, ability string) {
+	abilityMod := c.GetMod(ability)
+	stats := SpellcastingStats{
+		ClassType:      classType,
+		Ability:        ability,
+		SpellSaveDC:    8 + c.Proficiency + abilityMod,
+		SpellAttackMod: c.Proficiency + abilityMod,
+	}
+
+	idx := slices.IndexFunc(c.Spellcasting, func(s SpellcastingStats) bool { return strings.EqualFold(s.ClassType, classType) })
+	if idx == -1 {
+		c.Spellcasting = append(c.Spellcasting, stats)
+	} else {
+		c.Spellcasting[idx] = stats
+	}
+
+	c.SpellSaveDC = 0
+	c.SpellAttackMod = 0
+	for _, s := range c.Spellcasting {
+		c.SpellSaveDC = max(c.SpellSaveDC, s.SpellSaveDC)
+		c.SpellAttackMod = max(c.SpellAttackMod, s.SpellAttackMod)
+	}
+}
+
+// Spellcasting stats for the class a spell was learned through. Spells that aren't tied to a spellcasting class
+// use the only class that casts spells, or the character's best stats when there's more than one
+func (c *Character) GetSpellStats(spell shared.CharacterSpell) SpellcastingStats {
+	for _, s := range c.Spellcasting {
+		if spell.ClassType != "" && strings.EqualFold(s.ClassType, spell.ClassType) {
+			return s
+		}
+	}
+
+	if len(c.Spellcasting) == 1 {
+		return c.Spellcasting[0]
+	}
+
+	return SpellcastingStats{SpellSaveDC: c.SpellSaveDC, SpellAttackMod: c.SpellAttackMod}
+}
+
+// The spell save DC for each spellcasting class, ex: '13 (cleric), 15 (wizard)'
+func (c *Character) GetSpellSaveDCSummary() string {
+	if len(c.Spellcasting) <= 1 {
+		return fmt.Sprintf("%d", c.SpellSaveDC)
+	}
+
+	dcs := []string{}
+	for _, s := range c.Spellcasting {
+		dcs = append(dcs, fmt.Sprintf("%d (%s)", s.SpellSaveDC, s.ClassType))
+	}
+
+	return strings.Join(dcs, ", ")
+}
+
+// Ties a spell already in the spell list to the class it was learned through, if character only has one class a
+// classType is not required
+func (c *Character) SetSpellClass(spell string, classType string) error {
+	spellIdx := c.getSpellIdx(spell)
+	if spellIdx == -1 {
+		return fmt.Errorf("Character does not have spell '%s' in their spell list", spell)
+	}
+
+	for _, class := range c.Classes {
+		if !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
+			continue
+		}
+
+		c.Spells[spellIdx].ClassType = class.GetClassType()
+		return nil
+	}
+
+	return fmt.Errorf("Class '%s' not found for character", classType)
+}
+
+// Items like a ring of protection add to every saving throw
+func (c *Character) GetSavingThrowMod(abilityName string) int {
+	for _, ability := range c.Abilities {
+		if strings.EqualFold(ability.Name, abilityName) {
+			mod := ability.AbilityModifier + c.getMagicItemBonus(func(m shared.MagicItem) int { return m.SaveBonus })
+			if ability.SavingThrowsProficient || c.hasFeatSaveProficiency(ability.Name) {
+				mod += c.Proficiency
+			}
+
+			return mod
+		}
+	}
+
+	return 0
+}
+
 func (c *Character) GetMod(abilityName string) int {
 	for _, ability := range c.Abilities {
 		if strings.EqualFold(ability.Name, abilityName) {
@@ -288,6 +662,18 @@ func (c *Character) BuildCharacter() string {
 	}
 	builder.WriteString(nl)
 
+	background := c.BuildBackground()
+	for i := range background {
+		builder.WriteString(background[i])
+	}
+	builder.WriteString(nl)
+
+	racialTraits := c.BuildRacialTraits()
+	for i := range racialTraits {
+		builder.WriteString(racialTraits[i])
+	}
+	builder.WriteString(nl)
+
 	generalStats := c.BuildGeneralStats()
 	for i := range generalStats {
 		builder.WriteString(generalStats[i])
@@ -366,15 +752,16 @@ func (c *Character) BuildCharacter() string {
 func (c *Character) BuildHeader() []string {
 	header := "# DnD Character\n\n"
 	nameLine := fmt.Sprintf("**Name: %s**\n", c.Name)
+	xpLine := fmt.Sprintf("XP: %s\n", c.GetXPProgress())
 
-	s := []string{header, nameLine}
+	s := []string{header, nameLine, xpLine}
 	return s
 }
 
 func (c *Character) BuildCharacterInfo() []string {
 	levelLine := fmt.Sprintf("Level: %d\n", c.Level)
 	classLine := fmt.Sprintf("Class: %s\n", c.ClassTypes)
-	raceLine := fmt.Sprintf("Race: %s\n", c.Race)
+	raceLine := fmt.Sprintf("Race: %s\n", c.GetRaceName())
 	backgroundLine := fmt.Sprintf("Background: %s\n", c.Background)
 
 	s := []string{
@@ -388,17 +775,23 @@ func (c *Character) BuildCharacterInfo() []string {
 }
 
 func (c *Character) BuildFeats() []string {
-	s := make([]string, 0, len(c.Feats)+1)
+	feats := c.GetFeats()
+	s := make([]string, 0, len(feats)+1)
 
-	if len(c.Feats) < 1 || c.Feats[0].Name == "" {
+	if len(feats) < 1 {
 		return s
 	}
 
 	featLine := "- Feats:\n"
 	s = append(s, featLine)
 
-	for _, feat := range c.Feats {
-		featRow := fmt.Sprintf("	- %s: %s\n", feat.Name, feat.Desc)
+	for _, feat := range feats {
+		name := feat.Name
+		if feat.Ability != "" {
+			name += fmt.Sprintf(" (%s +1)", feat.Ability)
+		}
+
+		featRow := fmt.Sprintf("	- %s: %s\n", name, feat.Desc)
 		s = append(s, featRow)
 	}
 	s = append(s, "---")
@@ -407,11 +800,12 @@ func (c *Character) BuildFeats() []string {
 }
 
 func (c *Character) BuildLanguages() []string {
-	s := make([]string, 0, len(c.Languages)+1)
+	languages := c.GetLanguages()
+	s := make([]string, 0, len(languages)+1)
 	languagesLine := "- Languages:\n"
 	s = append(s, languagesLine)
 
-	for _, lang := range c.Languages {
+	for _, lang := range languages {
 		languageRow := fmt.Sprintf("	- %s\n", lang)
 		s = append(s, languageRow)
 	}
@@ -419,34 +813,99 @@ func (c *Character) BuildLanguages() []string {
 	return s
 }
 
+func (c *Character) BuildBackground() []string {
+	s := make([]string, 0, len(c.ToolProficiencies)+len(c.BackgroundDuplicates)+4)
+
+	if len(c.ToolProficiencies) > 0 {
+		s = append(s, "- Tool Proficiencies:\n")
+		for _, tool := range c.ToolProficiencies {
+			s = append(s, fmt.Sprintf("	- %s\n", tool))
+		}
+	}
+
+	if c.BackgroundFeature.Name != "" {
+		s = append(s, "- Background Feature:\n")
+		s = append(s, fmt.Sprintf("	- %s: %s\n", c.BackgroundFeature.Name, c.BackgroundFeature.Desc))
+	}
+
+	if len(c.BackgroundDuplicates) > 0 {
+		s = append(s, "- Background Duplicates (choose a replacement):\n")
+		for _, duplicate := range c.BackgroundDuplicates {
+			s = append(s, fmt.Sprintf("	- %s\n", duplicate))
+		}
+	}
+
+	return s
+}
+
+func (c *Character) BuildRacialTraits() []string {
+	s := make([]string, 0, len(c.RaceTraits)+3)
+
+	if c.Darkvision == 0 && len(c.Resistances) == 0 && len(c.RaceTraits) == 0 {
+		return s
+	}
+
+	s = append(s, "- Racial Traits:\n")
+
+	if c.Darkvision > 0 {
+		s = append(s, fmt.Sprintf("	- Darkvision: %d ft\n", c.Darkvision))
+	}
+
+	if len(c.Resistances) > 0 {
+		s = append(s, fmt.Sprintf("	- Resistances: %s\n", strings.Join(c.Resistances, ", ")))
+	}
+
+	for _, trait := range c.RaceTraits {
+		s = append(s, fmt.Sprintf("	- %s: %s\n", trait.Name, trait.Details))
+	}
+
+	return s
+}
+
 func (c *Character) BuildGeneralStats() []string {
 	nl := "\n"
 	proficiency := fmt.Sprintf("Proficiency: +%d\n", c.Proficiency)
 	passPerception := fmt.Sprintf("Passive Perception: %d\n", c.PassivePerception)
 	passInsight := fmt.Sprintf("Passive Insight: %d\n", c.PassiveInsight)
+	passInvestigation := fmt.Sprintf("Passive Investigation: %d\n", c.PassiveInvestigation)
+	initiativeLine := fmt.Sprintf("Initiative: %+d\n", c.Initiative)
 
 	acLine := fmt.Sprintf("AC: %d\n", c.AC)
-	ssdcLine := fmt.Sprintf("Spell Save DC: %d\n", c.SpellSaveDC)
-	speedLine := fmt.Sprintf("Speed: %d\n", c.Speed)
-	hpLine := fmt.Sprintf("HP: %d/%d", c.HPCurrent, c.HPMax)
+	ssdcLine := fmt.Sprintf("Spell Save DC: %s\n", c.GetSpellSaveDCSummary())
+	speedLine := fmt.Sprintf("Speed: %d\n", c.SpeedAdjusted)
+	hpLine := fmt.Sprintf("HP: %d/%d", c.HPCurrent, c.HPMaxAdjusted)
 
 	if c.HPTemp > 0 {
 		hpLine += fmt.Sprintf(" +%d", c.HPTemp)
 	}
 
+	if status := c.DeathSaveStatus(); status != "" {
+		hpLine += fmt.Sprintf("\n%s", status)
+	}
+
 	hitDiceLine := fmt.Sprintf("Hit Dice: %s\n", c.HitDice)
+	loadLine := fmt.Sprintf("Load: %s\n", c.GetLoad())
+
+	conditionsLine := ""
+	if conditions := c.GetConditions(); len(conditions) > 0 {
+		conditionsLine = fmt.Sprintf("Conditions: %s\n", strings.Join(conditions, ", "))
+	}
 
 	s := []string{
 		proficiency,
 		passPerception,
 		passInsight,
+		passInvestigation,
 		nl,
 		acLine,
+		initiativeLine,
 		ssdcLine,
 		speedLine,
 		hpLine,
 		nl,
 		hitDiceLine,
+		loadLine,
+		conditionsLine,
 	}
 
 	return s
@@ -463,10 +922,7 @@ func (c *Character) BuildAbilities() []string {
 	s = append(s, profSpacer)
 
 	for _, types := range c.Abilities {
-		abMod := types.AbilityModifier
-		if types.SavingThrowsProficient {
-			abMod += c.Proficiency
-		}
+		abMod := c.GetSavingThrowMod(types.Name)
 
 		abBaseString := ""
 		if types.AbilityModifier > 0 {
@@ -485,6 +941,24 @@ func (c *Character) BuildAbilities() []string {
 		s = append(s, profRow)
 	}
 
+	s = append(s, c.buildSavingThrowNotes()...)
+
+	return s
+}
+
+// Saving throw disadvantage from conditions, dexterity is listed separately when restrained
+func (c *Character) buildSavingThrowNotes() []string {
+	s := []string{}
+
+	note := c.RollNote(shared.RollTypeSavingThrow, "")
+	if note != "" {
+		s = append(s, fmt.Sprintf("\nSaving Throws: %s\n", note))
+	}
+
+	if dexNote := c.RollNote(shared.RollTypeSavingThrow, shared.AbilityDexterity); dexNote != note {
+		s = append(s, fmt.Sprintf("\nDexterity Saving Throws: %s\n", dexNote))
+	}
+
 	return s
 }
 
@@ -509,6 +983,10 @@ func (c *Character) BuildSkills() []string {
 		s = append(s, skillRow)
 	}
 
+	if note := c.RollNote(shared.RollTypeAbilityCheck, ""); note != "" {
+		s = append(s, fmt.Sprintf("\nAbility Checks: %s\n", note))
+	}
+
 	return s
 }
 
@@ -525,8 +1003,16 @@ func (c *Character) BuildSpells() []string {
 	spellHeader := "*Spells*\n\n"
 	s = append(s, spellHeader)
 
-	spellTopRow := "| Slot Level | Ritual | Spell | IsPrepared |\n"
-	spellSpacer := "| --- | --- | --- | --- |\n"
+	if c.Concentration != "" {
+		s = append(s, fmt.Sprintf("Concentrating on: %s\n\n", c.Concentration))
+	}
+
+	if preparedSummary := c.GetPreparedSpellsSummary(); preparedSummary != "" {
+		s = append(s, fmt.Sprintf("%s\n\n", preparedSummary))
+	}
+
+	spellTopRow := "| Slot Level | Ritual | Spell | IsPrepared | Save DC | Attack |\n"
+	spellSpacer := "| --- | --- | --- | --- | --- | --- |\n"
 	s = append(s, spellTopRow)
 	s = append(s, spellSpacer)
 
@@ -541,7 +1027,9 @@ func (c *Character) BuildSpells() []string {
 			pString = "*"
 		}
 
-		spellRow := fmt.Sprintf("| %d | %s | %s | %s |\n", spell.SlotLevel, rString, spell.Name, pString)
+		stats := c.GetSpellStats(spell)
+		spellRow := fmt.Sprintf("| %d | %s | %s | %s | %d | %+d |\n",
+			spell.SlotLevel, rString, spell.Name, pString, stats.SpellSaveDC, stats.SpellAttackMod)
 		s = append(s, spellRow)
 	}
 	s = append(s, nl)
@@ -550,16 +1038,26 @@ func (c *Character) BuildSpells() []string {
 	s = append(s, spellSlots)
 
 	for _, spellSlot := range c.SpellSlots {
-		if spellSlot.Maximum == 0 {
+		if spellSlot.Maximum == 0 && spellSlot.Available == 0 {
 			continue
 		}
 
 		fullCircle := strings.Repeat("● ", spellSlot.Available)
-		hollowCircle := strings.Repeat("○ ", (spellSlot.Maximum - spellSlot.Available))
-		slotRow := fmt.Sprintf("	- Level %d: %s%s\n", spellSlot.Level, fullCircle, hollowCircle)
+		hollowCircle := strings.Repeat("○ ", max(spellSlot.Maximum-spellSlot.Available, 0))
+		slotRow := fmt.Sprintf("	- Level %d: %s%s", spellSlot.Level, fullCircle, hollowCircle)
+		if spellSlot.Manual && spellSlot.Maximum != spellSlot.Expected {
+			slotRow += fmt.Sprintf("(manual, expected %d)", spellSlot.Expected)
+		}
+		slotRow += "\n"
 		s = append(s, slotRow)
 	}
 
+	for _, pactSlot := range c.GetPactMagicSlots() {
+		fullCircle := strings.Repeat("● ", pactSlot.Available)
+		hollowCircle := strings.Repeat("○ ", (pactSlot.Maximum - pactSlot.Available))
+		s = append(s, fmt.Sprintf("	- Pact Magic (Level %d): %s%s\n", pactSlot.Level, fullCircle, hollowCircle))
+	}
+
 	return s
 }
 
@@ -568,7 +1066,7 @@ func (c *Character) BuildWeapons() []string {
 	weaponsHeader := "*Weapons*\n\n"
 	s = append(s, weaponsHeader)
 
-	weaponTopRow := "| Weapon | Bonus | Damage | Type | Properties | Equipped |\n"
+	weaponTopRow := "| Weapon | To Hit | Damage | Type | Properties | Equipped |\n"
 	weaponSpacer := "| --- | --- | --- | --- | --- | --- |\n"
 	s = append(s, weaponTopRow)
 	s = append(s, weaponSpacer)
@@ -578,11 +1076,6 @@ func (c *Character) BuildWeapons() []string {
 	primaryEquippedChecked := false
 
 	for _, weapon := range c.Weapons {
-		wBonusString := ""
-		if weapon.Bonus > 0 {
-			wBonusString = "+"
-		}
-
 		equippedString := ""
 
 		if strings.EqualFold(c.PrimaryEquipped, weapon.Name) && !primaryEquippedChecked {
@@ -592,12 +1085,11 @@ func (c *Character) BuildWeapons() []string {
 			equippedString = "Secondary"
 		}
 
-		wBonusString = fmt.Sprintf("%s%d", wBonusString, weapon.Bonus)
 		weaponRow := fmt.Sprintf(
-			"| %s | %s | %s | %s | %s | %s |\n",
+			"| %s | %+d | %s | %s | %s | %s |\n",
 			weapon.Name,
-			wBonusString,
-			weapon.Damage,
+			weapon.AttackBonus,
+			GetWeaponDamage(weapon),
 			weapon.Type,
 			strings.Join(weapon.Properties, ", "),
 			equippedString)
@@ -605,6 +1097,10 @@ func (c *Character) BuildWeapons() []string {
 		s = append(s, weaponRow)
 	}
 
+	if note := c.RollNote(shared.RollTypeAttack, ""); note != "" {
+		s = append(s, fmt.Sprintf("\nAttack Rolls: %s\n", note))
+	}
+
 	return s
 }
 
@@ -615,32 +1111,27 @@ func (c *Character) BuildEquipment() []string {
 	s = append(s, equipmentHeader)
 	s = append(s, bodyEquipment)
 
-	if c.WornEquipment.Head != "" {
-		s = append(s, fmt.Sprintf("	- Head: %s\n", c.WornEquipment.Head))
-	}
-	if c.WornEquipment.Amulet != "" {
-		s = append(s, fmt.Sprintf("	- Amulet: %s\n", c.WornEquipment.Amulet))
-	}
-	if c.WornEquipment.Cloak != "" {
-		s = append(s, fmt.Sprintf("	- Cloak: %s\n", c.WornEquipment.Cloak))
+	wornItems := []struct {
+		label string
+		item  shared.MagicItem
+	}{
+		{"Head", c.WornEquipment.Head},
+		{"Amulet", c.WornEquipment.Amulet},
+		{"Cloak", c.WornEquipment.Cloak},
+		{"Hands", c.WornEquipment.HandsArms},
+		{"Ring", c.WornEquipment.Ring},
+		{"Ring", c.WornEquipment.Ring2},
+		{"Belt", c.WornEquipment.Belt},
+		{"Boots", c.WornEquipment.Boots},
 	}
+
 	if c.WornEquipment.Armor.Name != "" {
 		s = append(s, fmt.Sprintf("	- Armor: %s\n", c.WornEquipment.Armor.Name))
 	}
-	if c.WornEquipment.HandsArms != "" {
-		s = append(s, fmt.Sprintf("	- Hands: %s\n", c.WornEquipment.HandsArms))
-	}
-	if c.WornEquipment.Ring != "" {
-		s = append(s, fmt.Sprintf("	- Ring: %s\n", c.WornEquipment.Ring))
-	}
-	if c.WornEquipment.Ring2 != "" {
-		s = append(s, fmt.Sprintf("	- Ring: %s\n", c.WornEquipment.Ring2))
-	}
-	if c.WornEquipment.Belt != "" {
-		s = append(s, fmt.Sprintf("	- Belt: %s\n", c.WornEquipment.Belt))
-	}
-	if c.WornEquipment.Boots != "" {
-		s = append(s, fmt.Sprintf("	- Boots: %s\n", c.WornEquipment.Boots))
+	for _, worn := range wornItems {
+		if worn.item.Name != "" {
+			s = append(s, fmt.Sprintf("	- %s: %s\n", worn.label, FormatMagicItem(worn.item)))
+		}
 	}
 	if c.WornEquipment.Shield != "" {
 		shield := fmt.Sprintf("	- Shield: %s", c.WornEquipment.Shield)
@@ -651,6 +1142,28 @@ func (c *Character) BuildEquipment() []string {
 		s = append(s, shield+"\n")
 	}
 
+	s = append(s, c.buildAttunement()...)
+
+	return s
+}
+
+func (c *Character) buildAttunement() []string {
+	s := []string{}
+	attuned := c.GetAttunedItems()
+	unattuned := c.getUnattunedItems()
+	if len(attuned) == 0 && len(unattuned) == 0 {
+		return s
+	}
+
+	s = append(s, fmt.Sprintf("- Attunement (%s)\n", c.GetAttunement()))
+
+	if len(attuned) > 0 {
+		s = append(s, fmt.Sprintf("	- Attuned: %s\n", strings.Join(attuned, ", ")))
+	}
+	if len(unattuned) > 0 {
+		s = append(s, fmt.Sprintf("	- Requires Attunement: %s\n", strings.Join(unattuned, ", ")))
+	}
+
 	return s
 }
 
@@ -659,16 +1172,18 @@ func (c *Character) BuildBackpack() []string {
 	packHeader := "*Backpack*\n\n"
 	s = append(s, packHeader)
 
-	itemTopRow := "| Item | Quantity |\n"
-	itemSpacer := "| --- | --- |\n"
+	itemTopRow := "| Item | Quantity | Weight |\n"
+	itemSpacer := "| --- | --- | --- |\n"
 	s = append(s, itemTopRow)
 	s = append(s, itemSpacer)
 
 	for _, item := range c.Backpack {
-		itemRow := fmt.Sprintf("| %s | %d |\n", item.Name, item.Quantity)
+		itemRow := fmt.Sprintf("| %s | %d | %s |\n", item.Name, item.Quantity, FormatWeight(item.Weight))
 		s = append(s, itemRow)
 	}
 
+	s = append(s, fmt.Sprintf("\nPurse: %s\n", FormatCurrency(c.Purse)))
+
 	return s
 }
 
@@ -690,6 +1205,11 @@ func (c *Character) BuildAbilityScoreImprovement() []string {
 
 		// TODO: Make this more sophistocated so we don't need to loop through this twice
 		for _, ability := range c.AbilityScoreImprovement {
+			if ability.Feat != "" {
+				s = append(s, fmt.Sprintf("- feat: %s\n", ability.Feat))
+				continue
+			}
+
 			if ability.Ability == "" {
 				continue
 			}
@@ -703,121 +1223,1033 @@ func (c *Character) BuildAbilityScoreImprovement() []string {
 	return s
 }
 
-func GetSlots(available int, max int) string {
-	// using non breaking spaces for how lipgloss trims regular spaces at the end of strings
+func GetSlots(available int, maximum int) string {
+	// using non breaking spaces for how lipgloss trims regular spaces at the end of strings, created slots
+	// can put available over the maximum
 	fullCircle := strings.Repeat("●\u00A0", available)
-	hollowCircle := strings.Repeat("○\u00A0", (max - available))
+	hollowCircle := strings.Repeat("○\u00A0", max(maximum-available, 0))
 
 	return fmt.Sprintf("%s%s", fullCircle, hollowCircle)
 }
 
-// CLI Actions
+// Adds the damage bonus to the dice of a weapons damage, ex: '1d8 slashing' becomes '1d8+3 slashing'
+func GetWeaponDamage(weapon shared.Weapon) string {
+	if weapon.DamageBonus == 0 || weapon.Damage == "" {
+		return weapon.Damage
+	}
 
-func (c *Character) AddItemToPack(item string, quantity int) {
-	for i, packItem := range c.Backpack {
-		if strings.EqualFold(packItem.Name, item) {
-			c.Backpack[i].Quantity += quantity
-			return
+	dmgDice, dmgType, _ := strings.Cut(weapon.Damage, " ")
+	damage := fmt.Sprintf("%s%+d", dmgDice, weapon.DamageBonus)
+	if dmgType != "" {
+		damage += " " + dmgType
+	}
+
+	return damage
+}
+
+// Formats a purse from most to least valuable coin, ex: '15 gp, 5 sp'. Empty denominations are skipped
+func FormatCurrency(p shared.Purse) string {
+	coins := []string{}
+	for _, denomination := range shared.Currencies {
+		if amount := *purseCoins(&p, denomination); amount > 0 {
+			coins = append(coins, fmt.Sprintf("%d %s", amount, denomination))
 		}
 	}
 
-	newItem := shared.BackpackItem{
-		Name:     item,
-		Quantity: quantity,
+	if len(coins) == 0 {
+		return fmt.Sprintf("0 %s", shared.CurrencyGold)
 	}
 
-	c.Backpack = append(c.Backpack, newItem)
+	return strings.Join(coins, ", ")
 }
 
-func (c *Character) RemoveItemFromPack(item string, quantity int) error {
-	var err error
-	for i, packItem := range c.Backpack {
-		if strings.EqualFold(packItem.Name, item) {
-			if packItem.Quantity < quantity {
-				err = fmt.Errorf("Quantity to remove (%d) greater than quantity in pack (%d), set to 0",
-					quantity,
-					packItem.Quantity)
+var currencyRegex = regexp.MustCompile(`(?i)(\d+)\s*(cp|sp|ep|gp|pp)\b`)
 
-				c.Backpack[i].Quantity = 0
-				return err
-			}
+// Parses an amount of money like '15gp', '15 gp' or '3gp 5sp' into a purse
+func ParseCurrency(amount string) (shared.Purse, error) {
+	p := shared.Purse{}
 
-			c.Backpack[i].Quantity -= quantity
-			return nil
-		}
+	matches := currencyRegex.FindAllStringSubmatch(amount, -1)
+	leftover := strings.Trim(currencyRegex.ReplaceAllString(amount, ""), " ,")
+	if len(matches) == 0 || leftover != "" {
+		return p, fmt.Errorf("Invalid amount '%s', expected amounts like 15gp or '3gp 5sp' (cp, sp, ep, gp, pp)", amount)
+	}
+
+	for _, m := range matches {
+		quantity, err := strconv.Atoi(m[1])
+		if err != nil {
+			return p, fmt.Errorf("Invalid amount '%s':\n%w", m[0], err)
+		}
+
+		*purseCoins(&p, strings.ToLower(m[2])) += quantity
+	}
+
+	return p, nil
+}
+
+// Total value of a purse in copper pieces
+func GetCurrencyValue(p shared.Purse) int {
+	total := 0
+	for _, denomination := range shared.Currencies {
+		total += *purseCoins(&p, denomination) * shared.CurrencyValues[denomination]
+	}
+
+	return total
+}
+
+// Weight in pounds, ex: '2.5 lb', or '-' for items without a weight
+func FormatWeight(weight float64) string {
+	if weight <= 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%s lb", strconv.FormatFloat(weight, 'f', -1, 64))
+}
+
+// Number of coins of a single denomination in a purse
+func GetCurrencyAmount(p shared.Purse, denomination string) int {
+	return *purseCoins(&p, denomination)
+}
+
+func purseCoins(p *shared.Purse, denomination string) *int {
+	switch denomination {
+	case shared.CurrencyPlatinum:
+		return &p.Platinum
+	case shared.CurrencyGold:
+		return &p.Gold
+	case shared.CurrencyElectrum:
+		return &p.Electrum
+	case shared.CurrencySilver:
+		return &p.Silver
+	default:
+		return &p.Copper
+	}
+}
+
+// CLI Actions
+
+// Weight is per item, a weight of 0 leaves the weight of an item already in the pack unchanged
+func (c *Character) AddItemToPack(item string, quantity int, weight float64) {
+	defer c.updateEncumbrance()
+
+	for i, packItem := range c.Backpack {
+		if strings.EqualFold(packItem.Name, item) {
+			c.Backpack[i].Quantity += quantity
+			if weight > 0 {
+				c.Backpack[i].Weight = weight
+			}
+			return
+		}
+	}
+
+	newItem := shared.BackpackItem{
+		Name:     item,
+		Quantity: quantity,
+		Weight:   weight,
+	}
+
+	c.Backpack = append(c.Backpack, newItem)
+}
+
+func (c *Character) RemoveItemFromPack(item string, quantity int) error {
+	defer c.updateEncumbrance()
+
+	var err error
+	for i, packItem := range c.Backpack {
+		if strings.EqualFold(packItem.Name, item) {
+			if packItem.Quantity < quantity {
+				err = fmt.Errorf("Quantity to remove (%d) greater than quantity in pack (%d), set to 0",
+					quantity,
+					packItem.Quantity)
+
+				c.Backpack[i].Quantity = 0
+				return err
+			}
+
+			c.Backpack[i].Quantity -= quantity
+			return nil
+		}
 	}
 
 	err = fmt.Errorf("Item %s not found in pack", item)
 
-	return err
+	return err
+}
+
+func (c *Character) AddCurrency(amount shared.Purse) {
+	for _, denomination := range shared.Currencies {
+		*purseCoins(&c.Purse, denomination) += *purseCoins(&amount, denomination)
+	}
+}
+
+// Spends money from the purse, making change when the exact coins aren't available. We pay with coins no more
+// valuable than the largest denomination in the cost first, then break the smallest coin that covers the rest
+func (c *Character) SpendCurrency(cost shared.Purse) error {
+	remaining := GetCurrencyValue(cost)
+	available := GetCurrencyValue(c.Purse)
+	if remaining > available {
+		return fmt.Errorf("Not enough money to spend %s, purse has %s", FormatCurrency(cost), FormatCurrency(c.Purse))
+	}
+
+	largest := 0
+	for _, denomination := range shared.Currencies {
+		if *purseCoins(&cost, denomination) > 0 {
+			largest = max(largest, shared.CurrencyValues[denomination])
+		}
+	}
+
+	for _, denomination := range shared.Currencies {
+		value := shared.CurrencyValues[denomination]
+		if value > largest {
+			continue
+		}
+
+		coins := purseCoins(&c.Purse, denomination)
+		spent := min(*coins, remaining/value)
+		*coins -= spent
+		remaining -= spent * value
+	}
+
+	for remaining > 0 {
+		// Smallest coin that covers what's left, otherwise the most valuable coin in the purse
+		breakDenomination := ""
+		for _, denomination := range slices.Backward(shared.Currencies) {
+			if *purseCoins(&c.Purse, denomination) == 0 {
+				continue
+			}
+
+			breakDenomination = denomination
+			if shared.CurrencyValues[denomination] >= remaining {
+				break
+			}
+		}
+
+		value := shared.CurrencyValues[breakDenomination]
+		*purseCoins(&c.Purse, breakDenomination) -= 1
+
+		if value > remaining {
+			c.makeChange(value - remaining)
+		}
+		remaining = max(remaining-value, 0)
+	}
+
+	return nil
+}
+
+// Converts coins from one denomination to another, anything that doesn't divide evenly is returned as change
+func (c *Character) ConvertCurrency(amount int, from string, to string) error {
+	from, to = strings.ToLower(from), strings.ToLower(to)
+	fromValue, fromOk := shared.CurrencyValues[from]
+	toValue, toOk := shared.CurrencyValues[to]
+	if !fromOk || !toOk {
+		return fmt.Errorf("Invalid denomination, must be one of: %s", strings.Join(shared.Currencies, ", "))
+	}
+
+	coins := purseCoins(&c.Purse, from)
+	if amount <= 0 || amount > *coins {
+		return fmt.Errorf("Not enough %s to convert, purse has %d %s", from, *coins, from)
+	}
+
+	value := amount * fromValue
+	if value < toValue {
+		return fmt.Errorf("%d %s is not enough to convert to %s", amount, from, to)
+	}
+
+	*coins -= amount
+	*purseCoins(&c.Purse, to) += value / toValue
+	c.makeChange(value % toValue)
+
+	return nil
+}
+
+// Adds copper value back to the purse using the fewest coins. Electrum is skipped since it is rarely used as change
+func (c *Character) makeChange(value int) {
+	for _, denomination := range shared.Currencies {
+		if denomination == shared.CurrencyElectrum {
+			continue
+		}
+
+		coinValue := shared.CurrencyValues[denomination]
+		*purseCoins(&c.Purse, denomination) += value / coinValue
+		value %= coinValue
+	}
+}
+
+func (c *Character) AddLanguage(language string) {
+	c.Languages = append(c.Languages, language)
+}
+
+func (c *Character) AddEquipment(equipmentType string, equipmentName string, weight float64) {
+	equipmentName = strings.ToLower(equipmentName)
+	if slices.Contains(shared.WornEquipmentTypes[:], equipmentType) {
+		if c.WornEquipment.Weights == nil {
+			c.WornEquipment.Weights = make(map[string]float64)
+		}
+
+		c.WornEquipment.Weights[equipmentType] = weight
+		defer c.updateEncumbrance()
+	}
+
+	// Modifiers for known magic items are looked up by name when stats are calculated
+	item := shared.MagicItem{Name: equipmentName}
+
+	switch equipmentType {
+	case shared.WornEquipmentHead:
+		c.WornEquipment.Head = item
+	case shared.WornEquipmentAmulet:
+		c.WornEquipment.Amulet = item
+	case shared.WornEquipmentCloak:
+		c.WornEquipment.Cloak = item
+	case shared.WornEquipmentArmor:
+		c.WornEquipment.Armor.Name = equipmentName
+	case shared.WornEquipmentHandsArms:
+		c.WornEquipment.HandsArms = item
+	case shared.WornEquipmentRing:
+		c.WornEquipment.Ring = item
+	case shared.WornEquipmentRing2:
+		c.WornEquipment.Ring2 = item
+	case shared.WornEquipmentBelt:
+		c.WornEquipment.Belt = item
+	case shared.WornEquipmentBoots:
+		c.WornEquipment.Boots = item
+	default:
+		info := fmt.Sprintf("Invalid Equipment Type: %s", equipmentType)
+		logger.Info(info)
+	}
+}
+
+func (c *Character) AddLevel() {
+	c.Level += 1
+}
+
+// Levels up a class the character already has, raising hp max and spell slots. Choices like ability score
+// improvements and subclasses are flagged on the result so the caller can prompt for them
+func (c *Character) LevelUp(classType string, rollHP bool) (LevelUpResult, error) {
+	result := LevelUpResult{}
+	if c.Level >= shared.MaxLevel {
+		return result, fmt.Errorf("%s is already level %d", c.Name, shared.MaxLevel)
+	}
+
+	var class Class
+	for _, cl := range c.Classes {
+		if cl != nil && strings.EqualFold(cl.GetClassType(), classType) {
+			class = cl
+		}
+	}
+
+	if class == nil {
+		return result, fmt.Errorf("Class '%s' not found for character", classType)
+	}
+
+	class.SetClassLevel(1)
+	c.Level++
+
+	result.ClassType = class.GetClassType()
+	result.ClassLevel = class.GetClassLevel()
+	result.CharacterLevel = c.Level
+
+	// First character level always takes the max of the hit die, after that it's rolled or the fixed average
+	hitDie := class.GetHitDie()
+	switch {
+	case c.Level == 1:
+		result.HPRoll = hitDie
+	case rollHP:
+		result.HPRoll = dice.RollDie(hitDie)
+		result.HPRolled = true
+	default:
+		result.HPRoll = hitDie/2 + 1
+	}
+
+	result.HPGained = max(result.HPRoll+c.GetMod(shared.AbilityConstitution), 1)
+	c.HPMax += result.HPGained
+	c.HPCurrent += result.HPGained
+	c.calculateHPMax()
+	c.calculateHitDice()
+
+	result.Features = class.GetClassFeaturesAtLevel(result.ClassLevel)
+	result.AbilityScoreImprovement = class.IsAbilityScoreImprovementLevel()
+	result.SubClassChoice = class.NeedsSubClass()
+
+	if ksClass, ok := class.(KnownSpellClass); ok && result.ClassLevel > 1 {
+		ksClass.SetSpellSwap(true)
+		result.SpellSwap = true
+	}
+
+	c.calculateSpellSlots()
+	if getCasterType(getCasterKey(class)) != "" {
+		for _, slot := range c.SpellSlots {
+			if slot.Maximum > 0 {
+				result.SpellSlots = append(result.SpellSlots, slot)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Raising the maximum of a slot level also makes the new slots available. Slots with a manual maximum keep
+// it, the calculated value is only stored as Expected so the divergence can be shown
+func (c *Character) calculateSpellSlots() {
+	if len(c.Classes) == 0 {
+		return
+	}
+
+	classLevels := make(map[string]int)
+	for _, class := range c.Classes {
+		classLevels[getCasterKey(class)] += class.GetClassLevel()
+	}
+
+	for i, maximum := range GetSpellSlotMaximums(classLevels) {
+		level := i + 1
+		idx := slices.IndexFunc(c.SpellSlots, func(s shared.SpellSlot) bool { return s.Level == level })
+		if idx == -1 {
+			if maximum > 0 {
+				c.SpellSlots = append(c.SpellSlots, shared.SpellSlot{Level: level, Maximum: maximum, Available: maximum, Expected: maximum})
+			}
+			continue
+		}
+
+		// A slot that matches neither the table nor what the table gave last time was entered by hand,
+		// so it's kept as a manual override and shown as a divergence rather than overwritten
+		slot := &c.SpellSlots[idx]
+		if !slot.Manual && slot.Maximum != maximum && slot.Maximum != slot.Expected {
+			slot.Manual = true
+		}
+
+		slot.Expected = maximum
+		if slot.Manual {
+			continue
+		}
+
+		slot.Available = min(max(slot.Available+maximum-slot.Maximum, 0), maximum+slot.Created)
+		slot.Maximum = maximum
+	}
+
+	slices.SortFunc(c.SpellSlots, func(a, b shared.SpellSlot) int {
+		return a.Level - b.Level
+	})
+}
+
+// Classes are keyed by their subclass when it's the subclass that casts, ex: an eldritch knight fighter
+func getCasterKey(class Class) string {
+	subClass := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(class.GetSubClass())), "-", " ")
+	if _, ok := shared.SubClassCasterType[subClass]; ok {
+		return subClass
+	}
+
+	return strings.ToLower(class.GetClassType())
+}
+
+func getCasterType(key string) string {
+	if casterType, ok := shared.ClassCasterType[strings.ToLower(key)]; ok {
+		return casterType
+	}
+
+	return shared.SubClassCasterType[strings.ToLower(key)]
+}
+
+// Full casters add their whole class level, half casters add half of theirs and third casters a third.
+// Warlock levels don't count since Pact Magic slots are tracked separately. Keys are class types, or the
+// subclass for subclasses that cast
+func GetCasterLevel(classLevels map[string]int) int {
+	casterLevel := 0
+	partialCasters := []struct{ level, divisor int }{}
+	for key, level := range classLevels {
+		switch getCasterType(key) {
+		case shared.CasterTypeFull:
+			casterLevel += level
+		case shared.CasterTypeHalf:
+			partialCasters = append(partialCasters, struct{ level, divisor int }{level, 2})
+		case shared.CasterTypeThird:
+			partialCasters = append(partialCasters, struct{ level, divisor int }{level, 3})
+		}
+	}
+
+	// A lone half or third caster follows its own class table, which starts at level 2 (or 3) and rounds up
+	if casterLevel == 0 && len(partialCasters) == 1 {
+		pc := partialCasters[0]
+		if pc.level < pc.divisor {
+			return 0
+		}
+
+		return (pc.level + pc.divisor - 1) / pc.divisor
+	}
+
+	for _, pc := range partialCasters {
+		casterLevel += pc.level / pc.divisor
+	}
+
+	return min(casterLevel, shared.MaxLevel)
+}
+
+// Maximum spell slots for slot levels 1-9, keyed by lowercase class type
+func GetSpellSlotMaximums(classLevels map[string]int) [9]int {
+	casterLevel := GetCasterLevel(classLevels)
+	if casterLevel == 0 {
+		return [9]int{}
+	}
+
+	return shared.SpellSlotsByCasterLevel[casterLevel-1]
+}
+
+// Flexible Casting, sorcery points are turned into a spell slot that vanishes on a long rest
+func (c *Character) CreateSpellSlot(level int) error {
+	cost, ok := shared.SorceryPointSlotCost[level]
+	if !ok {
+		return fmt.Errorf("Invalid spell slot level '%d', created spell slots must be level 1-5", level)
+	}
+
+	spClass := c.getSorceryPointClass()
+	if spClass == nil {
+		return fmt.Errorf("No classes for character '%s' have sorcery points", c.Name)
+	}
+
+	if err := spClass.SpendSorceryPoints(cost); err != nil {
+		return fmt.Errorf("Failed to create level %d spell slot:\n%w", level, err)
+	}
+
+	idx := slices.IndexFunc(c.SpellSlots, func(s shared.SpellSlot) bool { return s.Level == level })
+	if idx == -1 {
+		c.SpellSlots = append(c.SpellSlots, shared.SpellSlot{Level: level})
+		slices.SortFunc(c.SpellSlots, func(a, b shared.SpellSlot) int {
+			return a.Level - b.Level
+		})
+		idx = slices.IndexFunc(c.SpellSlots, func(s shared.SpellSlot) bool { return s.Level == level })
+	}
+
+	c.SpellSlots[idx].Available++
+	c.SpellSlots[idx].Created++
+
+	return nil
+}
+
+// Flexible Casting, an expended spell slot gives sorcery points equal to its level
+func (c *Character) ConvertSpellSlot(level int) error {
+	spClass := c.getSorceryPointClass()
+	if spClass == nil {
+		return fmt.Errorf("No classes for character '%s' have sorcery points", c.Name)
+	}
+
+	idx := slices.IndexFunc(c.SpellSlots, func(s shared.SpellSlot) bool { return s.Level == level && s.Available > 0 })
+	if idx == -1 {
+		return fmt.Errorf("No level %d spell slots available", level)
+	}
+
+	if err := spClass.GainSorceryPoints(level); err != nil {
+		return fmt.Errorf("Failed to convert level %d spell slot:\n%w", level, err)
+	}
+
+	c.spendSpellSlot(idx)
+
+	return nil
+}
+
+func (c *Character) GetSorceryPoints() int {
+	spClass := c.getSorceryPointClass()
+	if spClass == nil {
+		return 0
+	}
+
+	return spClass.GetSorceryPoints()
+}
+
+func (c *Character) getSorceryPointClass() SorceryPointClass {
+	for _, class := range c.Classes {
+		if spClass, ok := class.(SorceryPointClass); ok {
+			return spClass
+		}
+	}
+
+	return nil
+}
+
+// Arcane Recovery, slot levels are given like "2" or "1,1" and can only restore expended slots below 6th level
+func (c *Character) ArcaneRecovery(slotLevels string) error {
+	var srClass SlotRecoveryClass
+	for _, class := range c.Classes {
+		if cls, ok := class.(SlotRecoveryClass); ok {
+			srClass = cls
+			break
+		}
+	}
+
+	if srClass == nil {
+		return fmt.Errorf("No classes for character '%s' implement arcane recovery", c.Name)
+	}
+
+	recoverByLevel := make(map[int]int)
+	total := 0
+	for _, part := range strings.Split(slotLevels, ",") {
+		level, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return fmt.Errorf("Invalid spell slot level '%s', slot levels should be formatted like '2' or '1,1'", part)
+		}
+
+		if level < 1 || level > 5 {
+			return fmt.Errorf("Invalid spell slot level '%d', recovered spell slots must be level 1-5", level)
+		}
+
+		recoverByLevel[level]++
+		total += level
+	}
+
+	recoveryLevels := srClass.GetSlotRecoveryLevels()
+	if total > recoveryLevels {
+		return fmt.Errorf("Spell slots have a combined level of %d, only %d can be recovered", total, recoveryLevels)
+	}
+
+	// We verify every slot is expended before recovering any of them
+	for level, count := range recoverByLevel {
+		expended := 0
+		idx := slices.IndexFunc(c.SpellSlots, func(s shared.SpellSlot) bool { return s.Level == level })
+		if idx != -1 {
+			expended = max(c.SpellSlots[idx].Maximum-c.SpellSlots[idx].Available, 0)
+		}
+
+		if count > expended {
+			return fmt.Errorf("Only %d level %d spell slots expended, %d requested", expended, level, count)
+		}
+	}
+
+	if err := srClass.UseSlotRecovery(); err != nil {
+		return err
+	}
+
+	for level, count := range recoverByLevel {
+		idx := slices.IndexFunc(c.SpellSlots, func(s shared.SpellSlot) bool { return s.Level == level })
+		c.SpellSlots[idx].Available += count
+	}
+
+	return nil
+}
+
+// Manually raising a slot maximum overrides the calculated value until the override is cleared
+func (c *Character) AddSpellSlotOverride(level int, quantity int) error {
+	if level < 1 || level > 9 {
+		return fmt.Errorf("Invalid spell slot level '%d', must be 1-9", level)
+	}
+
+	idx := slices.IndexFunc(c.SpellSlots, func(s shared.SpellSlot) bool { return s.Level == level })
+	if idx == -1 {
+		c.SpellSlots = append(c.SpellSlots, shared.SpellSlot{Level: level})
+		slices.SortFunc(c.SpellSlots, func(a, b shared.SpellSlot) int {
+			return a.Level - b.Level
+		})
+		idx = slices.IndexFunc(c.SpellSlots, func(s shared.SpellSlot) bool { return s.Level == level })
+	}
+
+	slot := &c.SpellSlots[idx]
+	slot.Manual = true
+	slot.Maximum = max(slot.Maximum+quantity, 0)
+	slot.Available = min(max(slot.Available+quantity, 0), slot.Maximum)
+
+	return nil
+}
+
+// Clearing an override returns the slot level to its calculated maximum
+func (c *Character) ClearSpellSlotOverride(level int) error {
+	for i, slot := range c.SpellSlots {
+		if slot.Level != level {
+			continue
+		}
+
+		if !slot.Manual {
+			return fmt.Errorf("Spell slot level '%d' doesn't have a manual override", level)
+		}
+
+		c.SpellSlots[i].Manual = false
+		c.SpellSlots[i].Available = min(max(slot.Available+slot.Expected-slot.Maximum, 0), slot.Expected)
+		c.SpellSlots[i].Maximum = slot.Expected
+		return nil
+	}
+
+	return fmt.Errorf("Spell slot level '%d' not found", level)
+}
+
+// Manual overrides that don't match the class tables, ex: 'level 1: 5 (expected 4)'
+func (c *Character) GetSpellSlotDivergences() []string {
+	divergences := []string{}
+	for _, slot := range c.SpellSlots {
+		if slot.Manual && slot.Maximum != slot.Expected {
+			divergences = append(divergences, fmt.Sprintf("level %d: %d (expected %d)", slot.Level, slot.Maximum, slot.Expected))
+		}
+	}
+
+	return divergences
+}
+
+// One ability gets +2, two abilities get +1 each
+func (c *Character) ApplyAbilityScoreImprovement(abilities []string) error {
+	if len(abilities) != 1 && len(abilities) != 2 {
+		return fmt.Errorf("Ability score improvements apply to one or two abilities")
+	}
+
+	for _, ability := range abilities {
+		if !isValidAbilityName(strings.TrimSpace(ability)) {
+			return fmt.Errorf("Ability name '%s' is not valid", ability)
+		}
+	}
+
+	bonus := 2 / len(abilities)
+	for _, ability := range abilities {
+		err := c.AddAbilityScoreImprovementItem(bonus, strings.ToLower(strings.TrimSpace(ability)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Character) AddXP(xp int) error {
+	if c.MilestoneLeveling {
+		return fmt.Errorf("%s levels by milestone, XP is not tracked", c.Name)
+	}
+
+	if xp < 0 && -xp > c.XP {
+		return fmt.Errorf("Can not remove %d XP, %s only has %d", -xp, c.Name, c.XP)
+	}
+
+	c.XP += xp
+
+	return nil
+}
+
+// Highest level the character's XP qualifies them for
+func (c *Character) GetLevelFromXP() int {
+	level := 1
+	for i, threshold := range shared.XPThresholds {
+		if c.XP >= threshold {
+			level = i + 1
+		}
+	}
+
+	return level
+}
+
+// XP needed to reach the level after the character's current level, 0 once they are max level
+func (c *Character) GetNextLevelXP() int {
+	if c.Level >= shared.MaxLevel {
+		return 0
+	}
+
+	return shared.XPThresholds[max(c.Level, 0)]
+}
+
+// With milestone leveling the DM decides when characters level up, so we never say one is available
+func (c *Character) IsLevelUpAvailable() bool {
+	return !c.MilestoneLeveling && c.Level < shared.MaxLevel && c.GetLevelFromXP() > c.Level
+}
+
+// Formatted like '350/900', or '900/900 (level up available)' once the character has enough XP
+func (c *Character) GetXPProgress() string {
+	if c.MilestoneLeveling {
+		return "milestone leveling"
+	}
+
+	if c.Level >= shared.MaxLevel {
+		return fmt.Sprintf("%d (max level)", c.XP)
+	}
+
+	progress := fmt.Sprintf("%d/%d", c.XP, c.GetNextLevelXP())
+	if c.IsLevelUpAvailable() {
+		progress += " (level up available)"
+	}
+
+	return progress
+}
+
+func (c *Character) HealCharacter(hpInc int) {
+	// Healing can't bring a character back from the dead, that takes magic like revivify
+	if c.IsDead() {
+		logger.Info("Character is dead")
+		return
+	}
+
+	c.HPCurrent += hpInc
+
+	if c.HPCurrent > c.HPMaxAdjusted {
+		c.HPCurrent = c.HPMaxAdjusted
+	}
+
+	// Any amount of healing brings a character back from dying
+	if c.HPCurrent > 0 {
+		c.DeathSaves = shared.DeathSaves{}
+	}
+}
+
+func (c *Character) DamageCharacter(hpDecr int) {
+	if c.IsDead() {
+		logger.Info("Character is dead")
+		return
+	}
+
+	// Any damage calls for a constitution save to keep concentrating, even if temp hp absorbs it
+	if c.Concentration != "" && hpDecr > 0 {
+		c.ConcentrationSaveDC = max(10, hpDecr/2)
+	}
+
+	// Dropping to 0 hp leaves the character unconscious, which always ends concentration
+	defer func() {
+		if c.HPCurrent <= 0 {
+			c.DropConcentration()
+		}
+	}()
+
+	if c.HPTemp > 0 {
+		c.HPTemp -= hpDecr
+
+		if c.HPTemp > 0 {
+			return
+		}
+
+		hpDecr = (c.HPTemp * -1)
+		c.HPTemp = 0
+	}
+
+	if hpDecr <= 0 {
+		return
+	}
+
+	// Taking damage at 0 HP is a failed death save, and knocks a stable character back into dying
+	if c.HPCurrent <= 0 {
+		if hpDecr >= c.HPMaxAdjusted {
+			c.DeathSaves.Failures = shared.DeathSaveThreshold
+			return
+		}
+
+		c.DeathSaves.Successes = 0
+		c.DeathSaves.Failures = min(c.DeathSaves.Failures+1, shared.DeathSaveThreshold)
+		return
+	}
+
+	c.HPCurrent -= hpDecr
+
+	// reset to zero if the decremented amount is greater than remaining health
+	if c.HPCurrent < 0 {
+		// Massive damage, the damage left over after dropping to 0 HP is at least the character's max HP
+		if c.HPCurrent*-1 >= c.HPMaxAdjusted {
+			c.DeathSaves.Failures = shared.DeathSaveThreshold
+		}
+
+		c.HPCurrent = 0
+	}
+}
+
+// Casts a known spell, using a spell slot of the given level. If no level is given the spell's own level is used,
+// cantrips don't use a slot. Casting a concentration spell ends concentration on any other spell, the name of
+// the dropped spell is returned
+func (c *Character) CastSpell(spellName string, slotLevel int, pact bool, metamagic string) (string, error) {
+	spellIdx := c.getSpellIdx(spellName)
+	if spellIdx == -1 {
+		return "", fmt.Errorf("Spell '%s' not found in known spells, check spelling", spellName)
+	}
+	spell := c.Spells[spellIdx]
+
+	if spell.SlotLevel > 0 && slotLevel != 0 && slotLevel < spell.SlotLevel {
+		return "", fmt.Errorf("'%s' is a level %d spell, it can't be cast with a level %d slot", spell.Name, spell.SlotLevel, slotLevel)
+	}
+
+	// Metamagic points are spent before the slot, and given back if there's no slot to cast with
+	var spClass SorceryPointClass
+	cost := 0
+	if metamagic != "" {
+		spClass = c.getSorceryPointClass()
+		if spClass == nil {
+			return "", fmt.Errorf("No classes for character '%s' implement metamagic", c.Name)
+		}
+
+		var err error
+		cost, err = spClass.GetMetamagicCost(metamagic, spell.SlotLevel)
+		if err != nil {
+			return "", fmt.Errorf("Failed to apply metamagic '%s':\n%w", metamagic, err)
+		}
+
+		if err = spClass.SpendSorceryPoints(cost); err != nil {
+			return "", fmt.Errorf("Failed to apply metamagic '%s':\n%w", metamagic, err)
+		}
+	}
+
+	if spell.SlotLevel > 0 {
+		if err := c.useSlotForSpell(spell, slotLevel, pact); err != nil {
+			if spClass != nil {
+				// Points that were just spent always fit under the maximum, so this can't fail
+				spClass.GainSorceryPoints(cost)
+			}
+
+			return "", err
+		}
+	}
+
+	if !spell.IsConcentration {
+		return "", nil
+	}
+
+	dropped := ""
+	if c.Concentration != "" && !strings.EqualFold(c.Concentration, spell.Name) {
+		dropped = c.Concentration
+	}
+
+	c.Concentration = spell.Name
+	c.ConcentrationSaveDC = 0
+
+	return dropped, nil
 }
 
-func (c *Character) AddLanguage(language string) {
-	c.Languages = append(c.Languages, language)
+func (c *Character) useSlotForSpell(spell shared.CharacterSpell, slotLevel int, pact bool) error {
+	if !pact {
+		for i, slot := range c.SpellSlots {
+			if slot.Level == max(slotLevel, spell.SlotLevel) && slot.Available > 0 {
+				c.spendSpellSlot(i)
+				return nil
+			}
+		}
+	}
+
+	// Pact Magic slots are always cast at the pact slot level, so any pact slot high enough will do
+	// unless a slot level was asked for
+	pactLevel := c.getPactMagicSlotLevel(spell.SlotLevel, slotLevel)
+	if pactLevel == 0 {
+		if pact {
+			return fmt.Errorf("No pact magic slots available to cast a level %d spell", spell.SlotLevel)
+		}
+
+		return fmt.Errorf("No level %d spell slots available", max(slotLevel, spell.SlotLevel))
+	}
+
+	return c.UsePactMagicSlot(pactLevel)
 }
 
-func (c *Character) AddEquipment(equipmentType string, equipmentName string) {
-	equipmentName = strings.ToLower(equipmentName)
-	switch equipmentType {
-	case shared.WornEquipmentHead:
-		c.WornEquipment.Head = equipmentName
-	case shared.WornEquipmentAmulet:
-		c.WornEquipment.Amulet = equipmentName
-	case shared.WornEquipmentCloak:
-		c.WornEquipment.Cloak = equipmentName
-	case shared.WornEquipmentArmor:
-		c.WornEquipment.Armor.Name = equipmentName
-	case shared.WornEquipmentHandsArms:
-		c.WornEquipment.HandsArms = equipmentName
-	case shared.WornEquipmentRing:
-		c.WornEquipment.Ring = equipmentName
-	case shared.WornEquipmentRing2:
-		c.WornEquipment.Ring2 = equipmentName
-	case shared.WornEquipmentBelt:
-		c.WornEquipment.Belt = equipmentName
-	case shared.WornEquipmentBoots:
-		c.WornEquipment.Boots = equipmentName
-	default:
-		info := fmt.Sprintf("Invalid Equipment Type: %s", equipmentType)
-		logger.Info(info)
+// Created slots are used before the character's own slots
+func (c *Character) spendSpellSlot(idx int) {
+	c.SpellSlots[idx].Available--
+	if c.SpellSlots[idx].Created > 0 {
+		c.SpellSlots[idx].Created--
 	}
 }
 
-func (c *Character) AddLevel() {
-	c.Level += 1
+// Level of an available Pact Magic slot that can cast a spell, 0 if there isn't one
+func (c *Character) getPactMagicSlotLevel(spellLevel int, slotLevel int) int {
+	for _, slot := range c.GetPactMagicSlots() {
+		if slot.Available > 0 && slot.Level >= spellLevel && (slotLevel == 0 || slot.Level == slotLevel) {
+			return slot.Level
+		}
+	}
+
+	return 0
 }
 
-func (c *Character) HealCharacter(hpInc int) {
-	c.HPCurrent += hpInc
+func (c *Character) DropConcentration() {
+	c.Concentration = ""
+	c.ConcentrationSaveDC = 0
+}
+
+// Rolls a constitution saving throw to keep concentrating on a spell, failing ends concentration.
+// A dc of 0 uses the dc from the last time the character took damage
+func (c *Character) RollConcentrationSave(dc int) (ConcentrationSaveResult, error) {
+	if c.Concentration == "" {
+		return ConcentrationSaveResult{}, fmt.Errorf("Character is not concentrating on a spell")
+	}
+
+	if dc == 0 {
+		dc = c.ConcentrationSaveDC
+	}
+
+	if dc == 0 {
+		return ConcentrationSaveResult{}, fmt.Errorf("No concentration save needed, specify a dc to roll anyway")
+	}
+
+	expression := fmt.Sprintf("1d20%+d", c.GetSavingThrowMod(shared.AbilityConstitution))
+	if c.HasFeat(shared.FeatWarCaster) {
+		expression += " " + dice.AdvantageKeyword
+	}
+
+	roll, lucky, err := c.rollD20(expression)
+	if err != nil {
+		return ConcentrationSaveResult{}, fmt.Errorf("Failed to roll concentration save:\n%w", err)
+	}
+
+	result := ConcentrationSaveResult{
+		Spell:      c.Concentration,
+		DC:         dc,
+		Roll:       roll,
+		Maintained: roll.Total >= dc,
+		Lucky:      lucky,
+		Note:       c.RollNote(shared.RollTypeSavingThrow, shared.AbilityConstitution),
+	}
 
-	if c.HPCurrent > c.HPMax {
-		c.HPCurrent = c.HPMax
+	c.ConcentrationSaveDC = 0
+	if !result.Maintained {
+		c.DropConcentration()
 	}
+
+	return result, nil
 }
 
-func (c *Character) DamageCharacter(hpDecr int) {
-	if c.HPCurrent <= 0 {
-		logger.Info("Character had no health left")
-		return
+// Dying characters are at 0 HP and have not yet stabilized or died
+func (c *Character) IsDying() bool {
+	return c.HPCurrent <= 0 && !c.IsStable() && !c.IsDead()
+}
+
+func (c *Character) IsStable() bool {
+	return c.HPCurrent <= 0 && c.DeathSaves.Successes >= shared.DeathSaveThreshold && !c.IsDead()
+}
+
+func (c *Character) IsDead() bool {
+	return c.DeathSaves.Failures >= shared.DeathSaveThreshold || c.Exhaustion >= shared.ExhaustionMaxLevel
+}
+
+// Rolls a death save for a dying character. A 10 or higher is a success, a natural 1 counts
+// as two failures, and a natural 20 brings the character back with 1 HP
+func (c *Character) RollDeathSave() (DeathSaveResult, error) {
+	if !c.IsDying() {
+		return DeathSaveResult{}, fmt.Errorf("Character is not dying")
 	}
 
-	if c.HPTemp > 0 {
-		c.HPTemp -= hpDecr
+	roll, lucky, err := c.rollD20("1d20")
+	if err != nil {
+		return DeathSaveResult{}, fmt.Errorf("Failed to roll death save:\n%w", err)
+	}
 
-		if c.HPTemp > 0 {
-			return
-		}
+	switch natural := roll.Natural(); {
+	case natural == 20:
+		c.HealCharacter(1)
+	case natural == 1:
+		c.DeathSaves.Failures = min(c.DeathSaves.Failures+2, shared.DeathSaveThreshold)
+	case natural >= 10:
+		c.DeathSaves.Successes++
+	default:
+		c.DeathSaves.Failures++
+	}
 
-		hpDecr = (c.HPTemp * -1)
-		c.HPTemp = 0
+	result := DeathSaveResult{
+		Roll:       roll,
+		Successes:  c.DeathSaves.Successes,
+		Failures:   c.DeathSaves.Failures,
+		Revived:    c.HPCurrent > 0,
+		Stabilized: c.IsStable(),
+		Dead:       c.IsDead(),
+		Lucky:      lucky,
 	}
 
-	c.HPCurrent -= hpDecr
+	return result, nil
+}
 
-	// reset to zero if the decremented amount is greater than remaining health
-	if c.HPCurrent < 0 {
-		c.HPCurrent = 0
+// Short summary of the characters death save progress, empty when the character is above 0 HP
+func (c *Character) DeathSaveStatus() string {
+	if c.HPCurrent > 0 && !c.IsDead() {
+		return ""
 	}
+
+	status := "Dying"
+	if c.IsDead() {
+		status = "Dead"
+	} else if c.IsStable() {
+		status = "Stable"
+	}
+
+	return fmt.Sprintf("%s (Successes: %d/%d, Failures: %d/%d)", status,
+		c.DeathSaves.Successes, shared.DeathSaveThreshold, c.DeathSaves.Failures, shared.DeathSaveThreshold)
 }
 
 func (c *Character) AddTempHp(tempHP int) {
@@ -847,7 +2279,7 @@ func (c *Character) AddAbilityScoreImprovementItem(quantity int, ability string)
 	}
 
 	for i, item := range c.AbilityScoreImprovement {
-		if strings.EqualFold(item.Ability, ability) {
+		if item.Feat == "" && strings.EqualFold(item.Ability, ability) {
 			c.AbilityScoreImprovement[i].Bonus += quantity
 			fmt.Println(c.AbilityScoreImprovement[i].Bonus)
 			return nil
@@ -871,7 +2303,7 @@ func (c *Character) ModifyAbilityScoreImprovementItem(quantity int, ability stri
 	}
 
 	for i, item := range c.AbilityScoreImprovement {
-		if strings.EqualFold(item.Ability, ability) {
+		if item.Feat == "" && strings.EqualFold(item.Ability, ability) {
 			c.AbilityScoreImprovement[i].Bonus = quantity
 			return nil
 		}
@@ -905,53 +2337,415 @@ func isValidAbilityName(abilityName string) bool {
 // 	c.Name = newName
 // }
 
+// Pact Magic slots are used once the character's spell slots for that level run out
 func (c *Character) UseSpellSlot(level int) {
 	for i := range c.SpellSlots {
 		if c.SpellSlots[i].Level == level {
 			if c.SpellSlots[i].Available <= 0 {
+				if c.UsePactMagicSlot(level) == nil {
+					return
+				}
+
 				info := fmt.Sprintf("Spell Slot Level %d: already at zero", level)
 				logger.Info(info)
 
 				return
 			}
 
-			c.SpellSlots[i].Available--
+			c.spendSpellSlot(i)
 			return
 		}
 	}
 
-	logger.Info("Invalid level, must be 1-9")
-}
+	if len(c.GetPactMagicSlots()) > 0 {
+		if err := c.UsePactMagicSlot(level); err != nil {
+			logger.Info(err.Error())
+		}
+
+		return
+	}
+
+	logger.Info("Invalid level, must be 1-9")
+}
+
+// Pact Magic slots belong to the class rather than the character, so a multiclass warlock has both pools
+func (c *Character) GetPactMagicSlots() []shared.SpellSlot {
+	slots := []shared.SpellSlot{}
+	for _, class := range c.Classes {
+		if scClass, ok := class.(SpellCasterClass); ok {
+			slots = append(slots, scClass.GetSpellSlots()...)
+		}
+	}
+
+	return slots
+}
+
+// Uses a Pact Magic slot of the given level, a level of 0 uses one of any level
+func (c *Character) UsePactMagicSlot(level int) error {
+	err := fmt.Errorf("Character has no pact magic slots")
+	for _, class := range c.Classes {
+		if scClass, ok := class.(SpellCasterClass); ok {
+			if err = scClass.UseSpellSlot(level); err == nil {
+				return nil
+			}
+		}
+	}
+
+	return err
+}
+
+// If no quantity is provided all Pact Magic slots are recovered
+func (c *Character) RecoverPactMagicSlots(quantity int) {
+	for _, class := range c.Classes {
+		if scClass, ok := class.(SpellCasterClass); ok {
+			scClass.RecoverSpellSlots(0, quantity)
+		}
+	}
+}
+
+func (c *Character) RecoverSpellSlots(level int, quantity int) {
+	for i := range c.SpellSlots {
+		if c.SpellSlots[i].Level == level {
+			if c.SpellSlots[i].Maximum == 0 {
+				logger.Warnf("Slot level '%d' cannot be recovered because the maximum 0", c.SpellSlots[i].Maximum)
+				return
+			} else if quantity == 0 {
+				c.SpellSlots[i].Available = c.SpellSlots[i].Maximum
+				return
+			}
+
+			c.SpellSlots[i].Available += quantity
+		}
+	}
+}
+
+func (c *Character) Recover() {
+	if c.IsDead() {
+		logger.Info("Character is dead")
+		return
+	}
+
+	// Finishing a long rest removes one level of exhaustion, which can raise the hit point maximum
+	if c.Exhaustion > 0 {
+		c.Exhaustion--
+		c.calculateHPMax()
+		c.applySpeedConditions()
+	}
+
+	c.HPCurrent = c.HPMaxAdjusted
+	c.DeathSaves = shared.DeathSaves{}
+
+	for i := range c.SpellSlots {
+		c.SpellSlots[i].Available = c.SpellSlots[i].Maximum
+		c.SpellSlots[i].Created = 0
+	}
+
+	if c.Classes == nil {
+		return
+	}
+
+	c.RecoverPactMagicSlots(0)
+
+	// A long rest recovers up to half of the characters total hit dice, with a minimum of one
+	hitDiceRecovered := max(c.Level/2, 1)
+	for _, class := range c.Classes {
+		used := class.GetClassLevel() - class.GetHitDiceAvailable()
+		recovered := min(used, hitDiceRecovered)
+
+		class.RecoverHitDice(recovered)
+		hitDiceRecovered -= recovered
+	}
+	c.calculateHitDice()
+
+	// We don't need to handle this error because not all characters have classes with tokens
+	c.RecoverClassTokens("", "", 0)
+}
+
+func (c *Character) HasCondition(condition string) bool {
+	for _, cond := range c.Conditions {
+		if strings.EqualFold(cond, condition) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Adds a condition to the character, adding exhaustion increases the exhaustion level by one
+func (c *Character) AddCondition(condition string) error {
+	condition = strings.ToLower(strings.TrimSpace(condition))
+
+	if condition == shared.ConditionExhaustion {
+		return c.SetExhaustion(c.Exhaustion + 1)
+	}
+
+	if !slices.Contains(shared.Conditions, condition) {
+		return fmt.Errorf("Condition '%s' not supported, supported conditions: %s", condition, strings.Join(shared.Conditions, ", "))
+	}
+
+	if c.HasCondition(condition) {
+		logger.Info(fmt.Sprintf("Character is already %s", condition))
+		return nil
+	}
+
+	c.Conditions = append(c.Conditions, condition)
+	c.applySpeedConditions()
+
+	return nil
+}
+
+// Removes a condition from the character, removing exhaustion decreases the exhaustion level by one
+func (c *Character) RemoveCondition(condition string) error {
+	condition = strings.ToLower(strings.TrimSpace(condition))
+
+	if condition == shared.ConditionExhaustion {
+		return c.SetExhaustion(c.Exhaustion - 1)
+	}
+
+	for i, cond := range c.Conditions {
+		if strings.EqualFold(cond, condition) {
+			c.Conditions = slices.Delete(c.Conditions, i, i+1)
+			c.applySpeedConditions()
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Character is not %s", condition)
+}
+
+func (c *Character) ClearConditions() {
+	c.Conditions = []string{}
+
+	// Zero is always a valid exhaustion level
+	c.SetExhaustion(0)
+}
+
+func (c *Character) SetExhaustion(level int) error {
+	if level < 0 || level > shared.ExhaustionMaxLevel {
+		return fmt.Errorf("Exhaustion level must be between 0 and %d", shared.ExhaustionMaxLevel)
+	}
+
+	c.Exhaustion = level
+	c.calculateHPMax()
+	c.applySpeedConditions()
+
+	return nil
+}
+
+// Current conditions for display, including the exhaustion level, ex: 'poisoned, exhaustion 2'
+func (c *Character) GetConditions() []string {
+	conditions := slices.Clone(c.Conditions)
+
+	if c.Exhaustion > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s %d", shared.ConditionExhaustion, c.Exhaustion))
+	}
+
+	return conditions
+}
+
+// Weight in pounds of everything in the backpack, worn equipment, and coins in the purse
+func (c *Character) GetCarriedWeight() float64 {
+	weight := 0.0
+	for _, item := range c.Backpack {
+		weight += item.Weight * float64(item.Quantity)
+	}
+
+	for _, w := range c.WornEquipment.Weights {
+		weight += w
+	}
+
+	coins := 0
+	for _, denomination := range shared.Currencies {
+		coins += *purseCoins(&c.Purse, denomination)
+	}
+
+	return weight + float64(coins)/float64(shared.CoinsPerPound)
+}
+
+// Going over carrying capacity is flagged with either rule set, the encumbered levels are only
+// used with variant encumbrance
+func (c *Character) GetEncumbrance() string {
+	strength := float64(c.CarryingCapacity / shared.CarryingCapacityMultiplier)
+
+	switch {
+	case c.CarriedWeight > float64(c.CarryingCapacity):
+		return shared.EncumbranceOver
+	case !c.VariantEncumbrance:
+		return shared.EncumbranceNone
+	case c.CarriedWeight > strength*float64(shared.HeavilyEncumberedMultiplier):
+		return shared.EncumbranceHeavy
+	case c.CarriedWeight > strength*float64(shared.EncumberedMultiplier):
+		return shared.EncumbranceEncumbered
+	}
+
+	return shared.EncumbranceNone
+}
+
+func (c *Character) SetVariantEncumbrance(enabled bool) {
+	c.VariantEncumbrance = enabled
+	c.updateEncumbrance()
+}
+
+func (c *Character) IsOverCapacity() bool {
+	return c.GetEncumbrance() == shared.EncumbranceOver
+}
+
+// Load formatted like '52.5/150 lb', followed by the encumbrance level when there is one
+func (c *Character) GetLoad() string {
+	load := fmt.Sprintf("%s/%d lb", strconv.FormatFloat(c.CarriedWeight, 'f', -1, 64), c.CarryingCapacity)
+	if encumbrance := c.GetEncumbrance(); encumbrance != shared.EncumbranceNone {
+		load += fmt.Sprintf(" (%s)", encumbrance)
+	}
+
+	return load
+}
+
+// Warning to show after picking something up, empty when the character is within their carrying capacity
+func (c *Character) GetLoadWarning() string {
+	if !c.IsOverCapacity() {
+		return ""
+	}
+
+	return fmt.Sprintf("Warning: %s is carrying %s, over their carrying capacity of %d lb",
+		c.Name, strconv.FormatFloat(c.CarriedWeight, 'f', -1, 64), c.CarryingCapacity)
+}
+
+// Describes any disadvantage the characters conditions impose on a roll type, empty if there is none.
+// The ability is only needed for saving throws, restrained only imposes disadvantage on dexterity saves
+func (c *Character) RollNote(rollType string, ability string) string {
+	sources := []string{}
+
+	for _, cond := range c.Conditions {
+		if slices.Contains(shared.ConditionDisadvantage[cond], rollType) {
+			sources = append(sources, cond)
+		}
+	}
+
+	if cond := shared.ConditionRestrained; rollType == shared.RollTypeSavingThrow &&
+		strings.EqualFold(ability, shared.AbilityDexterity) && c.HasCondition(cond) {
+		sources = append(sources, cond)
+	}
+
+	// Heavily encumbered only affects physical rolls, so without an ability we note which ones
+	if encumbrance := c.GetEncumbrance(); c.VariantEncumbrance && encumbrance != shared.EncumbranceEncumbered &&
+		encumbrance != shared.EncumbranceNone {
+		physical := []string{shared.AbilityStrength, shared.AbilityDexterity, shared.AbilityConstitution}
+		if ability == "" {
+			sources = append(sources, fmt.Sprintf("%s: str, dex, con", shared.EncumbranceHeavy))
+		} else if slices.Contains(physical, strings.ToLower(ability)) {
+			sources = append(sources, shared.EncumbranceHeavy)
+		}
+	}
+
+	exhaustion := fmt.Sprintf("%s %d", shared.ConditionExhaustion, c.Exhaustion)
+	switch {
+	case rollType == shared.RollTypeAbilityCheck && c.Exhaustion >= 1:
+		sources = append(sources, exhaustion)
+	case rollType != shared.RollTypeAbilityCheck && c.Exhaustion >= 3:
+		sources = append(sources, exhaustion)
+	}
+
+	notes := []string{}
+	if len(sources) > 0 {
+		notes = append(notes, fmt.Sprintf("Disadvantage (%s)", strings.Join(sources, ", ")))
+	}
+
+	// Racial advantages like Dwarven Resilience depend on what the save is against, so they're noted too
+	advantages := []string{}
+	for _, trait := range c.RaceTraits {
+		if rollType == shared.RollTypeSavingThrow && trait.SaveAdvantage != "" {
+			advantages = append(advantages, fmt.Sprintf("%s: %s", trait.Name, trait.SaveAdvantage))
+		}
+	}
+
+	if len(advantages) > 0 {
+		notes = append(notes, fmt.Sprintf("Advantage (%s)", strings.Join(advantages, ", ")))
+	}
+
+	return strings.Join(notes, "; ")
+}
+
+// Spends hit dice to recover health, and recovers any class resources that come back on a short rest.
+// Hit dice are specified like '2d10' or '1d10+1d8' for multiclass characters, an empty string spends none.
+// The constitution modifier is added to each die spent
+func (c *Character) ShortRest(spend string) ([]dice.Result, error) {
+	results := []dice.Result{}
+
+	if spend != "" {
+		e, err := dice.Parse(spend)
+		if err != nil {
+			return results, fmt.Errorf("Failed to parse hit dice to spend:\n%w", err)
+		}
+
+		if e.Modifier != 0 || len(e.DiceTerms) == 0 {
+			return results, fmt.Errorf("Hit dice should be formatted like '2d10', modifiers are not allowed")
+		}
+
+		// We verify every die can be spent before spending any of them
+		dieOrder := []int{}
+		spendByDie := make(map[int]int)
+		for _, term := range e.DiceTerms {
+			if term.Count < 0 || term.Keep > 0 {
+				return results, fmt.Errorf("Hit dice should be formatted like '2d10', subtracting or keeping dice is not allowed")
+			}
+
+			if _, exists := spendByDie[term.Sides]; !exists {
+				dieOrder = append(dieOrder, term.Sides)
+			}
+			spendByDie[term.Sides] += term.Count
+		}
+
+		for _, die := range dieOrder {
+			count := spendByDie[die]
+			available := 0
+			for _, class := range c.Classes {
+				if class.GetHitDie() == die {
+					available += class.GetHitDiceAvailable()
+				}
+			}
+
+			if count > available {
+				return results, fmt.Errorf("Only %d d%d hit dice available, %d requested", available, die, count)
+			}
+		}
+
+		conMod := c.GetMod(shared.AbilityConstitution)
+		for _, die := range dieOrder {
+			count := spendByDie[die]
+			remaining := count
+			for _, class := range c.Classes {
+				if class.GetHitDie() != die || remaining == 0 {
+					continue
+				}
+
+				classSpend := min(remaining, class.GetHitDiceAvailable())
+				if err := class.UseHitDice(classSpend); err != nil {
+					return results, fmt.Errorf("Failed to spend hit dice for class '%s':\n%w", class.GetClassType(), err)
+				}
 
-func (c *Character) RecoverSpellSlots(level int, quantity int) {
-	for i := range c.SpellSlots {
-		if c.SpellSlots[i].Level == level {
-			if c.SpellSlots[i].Maximum == 0 {
-				logger.Warnf("Slot level '%d' cannot be recovered because the maximum 0", c.SpellSlots[i].Maximum)
-				return
-			} else if quantity == 0 {
-				c.SpellSlots[i].Available = c.SpellSlots[i].Maximum
-				return
+				remaining -= classSpend
 			}
 
-			c.SpellSlots[i].Available += quantity
+			result, err := dice.Roll(fmt.Sprintf("%dd%d%+d", count, die, conMod*count))
+			if err != nil {
+				return results, fmt.Errorf("Failed to roll hit dice:\n%w", err)
+			}
+
+			results = append(results, result)
+			c.HealCharacter(max(result.Total, 0))
 		}
 	}
-}
-
-func (c *Character) Recover() {
-	c.HPCurrent = c.HPMax
 
-	for i := range c.SpellSlots {
-		c.SpellSlots[i].Available = c.SpellSlots[i].Maximum
+	for _, class := range c.Classes {
+		if srClass, ok := class.(ShortRestClass); ok {
+			srClass.RecoverShortRestTokens()
+		}
 	}
 
-	if c.Classes == nil {
-		return
-	}
+	c.calculateHitDice()
 
-	// We don't need to handle this error because not all characters have classes with tokens
-	c.RecoverClassTokens("", "", 0)
+	return results, nil
 }
 
 // Uses class tokens by tokenName, and class type. No token name is required if the class only has one token. If a character only has one class, a class name is not required
@@ -973,18 +2767,23 @@ func (c *Character) UseClassTokens(tokenName string, classType string, quantity
 // If token name is not provided, we will perform a full token recovery of all tokens for a given class.
 // If class type is not provided, we will perform a full token recovery for all classes
 func (c *Character) RecoverClassTokens(tokenName string, classType string, quantity int) error {
+	recovered := false
 	for i, class := range c.Classes {
-		if !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
+		if classType != "" && !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
 			continue
 		}
 
 		if tokenClass, ok := c.Classes[i].(TokenClass); ok {
-			tokenClass.RecoverClassTokens("", quantity)
-			return nil
+			tokenClass.RecoverClassTokens(tokenName, quantity)
+			recovered = true
 		}
 	}
 
-	return fmt.Errorf("No classes for character '%s' implement tokens", c.Name)
+	if !recovered {
+		return fmt.Errorf("No classes for character '%s' implement tokens", c.Name)
+	}
+
+	return nil
 }
 
 func (c *Character) Equip(isPrimary bool, name string) error {
@@ -1037,6 +2836,187 @@ func (c *Character) Unequip(isPrimary bool) {
 	}
 }
 
+// Returns the name of the equipped weapon for the primary or secondary hand
+func (c *Character) GetEquippedWeapon(isPrimary bool) (string, error) {
+	name := c.SecondaryEquipped
+	hand := "secondary"
+	if isPrimary {
+		name = c.PrimaryEquipped
+		hand = "primary"
+	}
+
+	if name == "" {
+		return "", fmt.Errorf("No weapon equipped in %s hand", hand)
+	}
+
+	return name, nil
+}
+
+// Rolls to hit and damage for a weapon by name. A natural 20 is a critical hit and doubles the damage dice,
+// a natural 1 is a critical miss and no damage is rolled
+func (c *Character) Attack(weaponName string, advantage bool, disadvantage bool) (AttackResult, error) {
+	result := AttackResult{}
+
+	weaponIdx := -1
+	for i, weapon := range c.Weapons {
+		if strings.EqualFold(weapon.Name, weaponName) {
+			weaponIdx = i
+			break
+		}
+	}
+
+	if weaponIdx == -1 {
+		return result, fmt.Errorf("Weapon '%s' not found, check spelling", weaponName)
+	}
+
+	weapon := c.Weapons[weaponIdx]
+	result.Weapon = weapon.Name
+
+	// Conditions are noted rather than applied, some like frightened depend on the situation
+	result.Note = c.RollNote(shared.RollTypeAttack, "")
+	result.Options = c.getAttackOptions(weapon)
+
+	if weapon.Damage == "" {
+		return result, fmt.Errorf("Weapon '%s' has no damage to roll", weapon.Name)
+	}
+
+	hitExpression := fmt.Sprintf("1d20%+d", weapon.AttackBonus)
+	if advantage && !disadvantage {
+		hitExpression += " " + dice.AdvantageKeyword
+	} else if disadvantage && !advantage {
+		hitExpression += " " + dice.DisadvantageKeyword
+	}
+
+	toHit, lucky, err := c.rollD20(hitExpression)
+	if err != nil {
+		return result, fmt.Errorf("Failed to roll to hit for '%s':\n%w", weapon.Name, err)
+	}
+	result.ToHit = toHit
+	result.Lucky = lucky
+	result.Critical = toHit.Natural() == 20
+	result.CriticalMiss = toHit.Natural() == 1
+
+	if result.CriticalMiss {
+		return result, nil
+	}
+
+	damageExpression, err := dice.Parse(weapon.Damage)
+	if err != nil {
+		return result, fmt.Errorf("Failed to parse damage for '%s':\n%w", weapon.Name, err)
+	}
+	damageExpression.Modifier += weapon.DamageBonus
+
+	if result.Critical {
+		damageExpression = damageExpression.Critical()
+	}
+
+	result.Damage = dice.RollExpression(damageExpression)
+
+	return result, nil
+}
+
+func (r AttackResult) String() string {
+	s := fmt.Sprintf("%s\nTo Hit %s", r.Weapon, r.ToHit.String())
+
+	if r.Lucky {
+		s += fmt.Sprintf("\n%s", luckyNote)
+	}
+
+	if r.Note != "" {
+		s += fmt.Sprintf("\nConditions: %s", r.Note)
+	}
+
+	for _, option := range r.Options {
+		s += fmt.Sprintf("\nOption: %s", option)
+	}
+
+	if r.CriticalMiss {
+		return s + "\nCritical Miss!"
+	}
+
+	if r.Critical {
+		s += "\nCritical Hit!"
+	}
+
+	s += fmt.Sprintf("\nDamage %s", r.Damage.String())
+
+	return s
+}
+
+func (r ConcentrationSaveResult) String() string {
+	s := fmt.Sprintf("Concentration Save (%s), DC %d\n%s", r.Spell, r.DC, r.Roll.String())
+
+	if r.Lucky {
+		s += fmt.Sprintf("\n%s", luckyNote)
+	}
+
+	if r.Note != "" {
+		s += fmt.Sprintf("\nConditions: %s", r.Note)
+	}
+
+	if r.Maintained {
+		return s + "\nConcentration maintained"
+	}
+
+	return s + fmt.Sprintf("\nConcentration on %s lost", r.Spell)
+}
+
+func (r LevelUpResult) String() string {
+	s := fmt.Sprintf("%s is now level %d (character level %d)", r.ClassType, r.ClassLevel, r.CharacterLevel)
+
+	hpSource := "average"
+	if r.HPRolled {
+		hpSource = "rolled"
+	}
+	s += fmt.Sprintf("\nHP max +%d (%s %d, constitution %+d)", r.HPGained, hpSource, r.HPRoll, r.HPGained-r.HPRoll)
+
+	for _, feature := range r.Features {
+		s += fmt.Sprintf("\nNew feature: %s", feature.Name)
+	}
+
+	if len(r.SpellSlots) > 0 {
+		slots := []string{}
+		for _, slot := range r.SpellSlots {
+			slots = append(slots, fmt.Sprintf("level %d: %d", slot.Level, slot.Maximum))
+		}
+		s += fmt.Sprintf("\nSpell slots: %s", strings.Join(slots, ", "))
+	}
+
+	if r.AbilityScoreImprovement {
+		s += "\nAbility score improvement: +2 to one ability, +1 to two abilities, or a feat"
+	}
+
+	if r.SubClassChoice {
+		s += fmt.Sprintf("\nChoose a subclass for %s", r.ClassType)
+	}
+
+	if r.SpellSwap {
+		s += fmt.Sprintf("\nYou can replace one %s spell you know with another", r.ClassType)
+	}
+
+	return s
+}
+
+func (r DeathSaveResult) String() string {
+	s := fmt.Sprintf("Death Save %s", r.Roll.String())
+
+	if r.Lucky {
+		s += fmt.Sprintf("\n%s", luckyNote)
+	}
+
+	switch {
+	case r.Revived:
+		return s + "\nNatural 20, back on your feet with 1 HP!"
+	case r.Dead:
+		return s + "\nThree failures, character has died"
+	case r.Stabilized:
+		return s + "\nThree successes, character is stable"
+	}
+
+	return s + fmt.Sprintf("\nSuccesses: %d/%d, Failures: %d/%d",
+		r.Successes, shared.DeathSaveThreshold, r.Failures, shared.DeathSaveThreshold)
+}
+
 // Adds expertise skill to specified class type, if character only has one class a classType is not required
 func (c *Character) AddExpertiseSkill(skill string, classType string) error {
 	for i, class := range c.Classes {
@@ -1072,6 +3052,12 @@ func (c *Character) AddPreparedSpell(spell string, classType string) error {
 				return fmt.Errorf("Character does not have spell '%s' in their spell list", spell)
 			}
 
+			preparedSpellsMax := psClass.GetPreparedSpellsMax()
+			if !c.ValidationDisabled && len(psClass.GetPreparedSpells()) >= preparedSpellsMax {
+				return fmt.Errorf("Class '%s' can only prepare %d spells, remove a prepared spell first",
+					class.GetClassType(), preparedSpellsMax)
+			}
+
 			err := psClass.AddPreparedSpell(spell)
 			if err != nil {
 				return fmt.Errorf("Failed to add prepared spell '%s:\n%w'", spell, err)
@@ -1088,6 +3074,131 @@ func (c *Character) AddPreparedSpell(spell string, classType string) error {
 	return fmt.Errorf("No classes for character '%s' implement prepared spells", c.Name)
 }
 
+// Adds a spell learned by the specified class type, if character only has one class a classType is not required.
+// Multiclassed characters need a class type matching one of their classes, so the spell is checked against
+// that class's number of spells known
+func (c *Character) AddSpell(spell shared.CharacterSpell, classType string) error {
+	if c.getSpellIdx(spell.Name) != -1 {
+		return fmt.Errorf("Spell '%s' is already in the spell list", spell.Name)
+	}
+
+	if len(c.Classes) > 1 && !slices.ContainsFunc(c.Classes, func(class Class) bool {
+		return strings.EqualFold(classType, class.GetClassType())
+	}) {
+		if classType == "" {
+			return fmt.Errorf("Class type is required for multi-class characters")
+		}
+
+		return fmt.Errorf("Class '%s' not found for character", classType)
+	}
+
+	for _, class := range c.Classes {
+		if !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
+			continue
+		}
+
+		spell.ClassType = class.GetClassType()
+
+		if ksClass, ok := class.(KnownSpellClass); ok && !c.ValidationDisabled {
+			spellsKnown, cantripsKnown := c.GetKnownSpellCounts(class.GetClassType())
+
+			if spell.SlotLevel == 0 && cantripsKnown >= ksClass.GetCantripsKnown() {
+				return fmt.Errorf("Class '%s' already knows %d of %d cantrips", class.GetClassType(), cantripsKnown, ksClass.GetCantripsKnown())
+			}
+
+			if spell.SlotLevel > 0 && spellsKnown >= ksClass.GetSpellsKnown() {
+				return fmt.Errorf("Class '%s' already knows %d of %d spells, swap one after leveling up instead",
+					class.GetClassType(), spellsKnown, ksClass.GetSpellsKnown())
+			}
+		}
+
+		break
+	}
+
+	c.Spells = append(c.Spells, spell)
+	slices.SortStableFunc(c.Spells, func(a, b shared.CharacterSpell) int {
+		return a.SlotLevel - b.SlotLevel
+	})
+
+	return nil
+}
+
+// Replaces a known spell with another for specified class type, if character only has one class a classType is
+// not required. Known spell classes get one swap each time they level up, cantrips can't be swapped
+func (c *Character) SwapKnownSpell(oldSpell string, newSpell shared.CharacterSpell, classType string) error {
+	for _, class := range c.Classes {
+		if !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
+			continue
+		}
+
+		ksClass, ok := class.(KnownSpellClass)
+		if !ok {
+			return fmt.Errorf("Class '%s' is not one that implements known spells", c.ClassTypes)
+		}
+
+		if !ksClass.GetSpellSwap() && !c.ValidationDisabled {
+			return fmt.Errorf("No spell swap available for class '%s', one is gained each time the class levels up", class.GetClassType())
+		}
+
+		oldIdx := slices.IndexFunc(c.Spells, func(s shared.CharacterSpell) bool {
+			return strings.EqualFold(s.Name, oldSpell) && c.isKnownByClass(s, class.GetClassType())
+		})
+		if oldIdx == -1 {
+			return fmt.Errorf("Spell '%s' is not a spell known by class '%s'", oldSpell, class.GetClassType())
+		}
+
+		if c.Spells[oldIdx].SlotLevel == 0 || newSpell.SlotLevel == 0 {
+			return fmt.Errorf("Cantrips can't be swapped when leveling up")
+		}
+
+		if c.getSpellIdx(newSpell.Name) != -1 {
+			return fmt.Errorf("Spell '%s' is already in the spell list", newSpell.Name)
+		}
+
+		newSpell.ClassType = class.GetClassType()
+		c.Spells[oldIdx] = newSpell
+		slices.SortStableFunc(c.Spells, func(a, b shared.CharacterSpell) int {
+			return a.SlotLevel - b.SlotLevel
+		})
+
+		if c.Concentration != "" && strings.EqualFold(c.Concentration, oldSpell) {
+			c.DropConcentration()
+		}
+
+		ksClass.SetSpellSwap(false)
+		return nil
+	}
+
+	return fmt.Errorf("No classes for character '%s' implement known spells", c.Name)
+}
+
+// Counts the spells and cantrips learned by a class type
+func (c *Character) GetKnownSpellCounts(classType string) (int, int) {
+	spells, cantrips := 0, 0
+	for _, spell := range c.Spells {
+		if !c.isKnownByClass(spell, classType) {
+			continue
+		}
+
+		if spell.SlotLevel == 0 {
+			cantrips++
+		} else {
+			spells++
+		}
+	}
+
+	return spells, cantrips
+}
+
+// Spells added before they were tied to a class only count when there's a single class they could belong to
+func (c *Character) isKnownByClass(spell shared.CharacterSpell, classType string) bool {
+	if spell.ClassType == "" {
+		return len(c.Classes) == 1
+	}
+
+	return strings.EqualFold(spell.ClassType, classType)
+}
+
 // Removes prepared spell to specified class type, if character only has one class a classType is not required
 func (c *Character) RemovePreparedSpell(spell string, classType string) error {
 	for i, class := range c.Classes {
@@ -1117,6 +3228,55 @@ func (c *Character) RemovePreparedSpell(spell string, classType string) error {
 	return fmt.Errorf("No classes for character '%s' implement prepared spells", c.Name)
 }
 
+// Add spell to the spellbook of specified class type, if character only has one class a classType is not required
+func (c *Character) AddSpellbookSpell(spell string, classType string) error {
+	for i, class := range c.Classes {
+		if !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
+			continue
+		}
+
+		if sbClass, ok := c.Classes[i].(SpellbookClass); ok {
+			err := sbClass.AddSpellbookSpell(spell)
+			if err != nil {
+				return fmt.Errorf("Failed to add spellbook spell '%s':\n%w", spell, err)
+			}
+		} else {
+			return fmt.Errorf("Class '%s' is not one that implements a spellbook", c.ClassTypes)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("No classes for character '%s' implement a spellbook", c.Name)
+}
+
+// Remove spell from the spellbook of specified class type, if character only has one class a classType is not required
+func (c *Character) RemoveSpellbookSpell(spell string, classType string) error {
+	for i, class := range c.Classes {
+		if !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
+			continue
+		}
+
+		if sbClass, ok := c.Classes[i].(SpellbookClass); ok {
+			err := sbClass.RemoveSpellbookSpell(spell)
+			if err != nil {
+				return fmt.Errorf("Failed to remove spellbook spell '%s':\n%w", spell, err)
+			}
+
+			// A spell that's no longer in the book can't stay prepared
+			if spellIdx := c.getSpellIdx(spell); spellIdx != -1 {
+				c.Spells[spellIdx].IsPrepared = false
+			}
+		} else {
+			return fmt.Errorf("Class '%s' is not one that implements a spellbook", c.ClassTypes)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("No classes for character '%s' implement a spellbook", c.Name)
+}
+
 // Modifies fighting style for specified class type, if character only has one class a classType is not required
 func (c *Character) ModifyFightingStyle(fightingStyle string, classType string) error {
 	for i, class := range c.Classes {
@@ -1227,6 +3387,72 @@ func (c *Character) RemoveFavoredEnemy(favoredEnemy string, classType string) er
 	return fmt.Errorf("No classes for character '%s' implement favored enemy", c.Name)
 }
 
+// Add eldritch invocation to specified class type, if character only has one class a classType is not required
+func (c *Character) AddInvocation(invocation string, classType string) error {
+	for i, class := range c.Classes {
+		if !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
+			continue
+		}
+
+		if iClass, ok := c.Classes[i].(InvocationClass); ok {
+			err := iClass.AddInvocation(invocation)
+			if err != nil {
+				return fmt.Errorf("Failed to add invocation '%s':\n%w", invocation, err)
+			}
+		} else {
+			return fmt.Errorf("Class '%s' is not one that implements invocations", c.ClassTypes)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("No classes for character '%s' implement invocations", c.Name)
+}
+
+// Remove eldritch invocation from specified class type, if character only has one class a classType is not required
+func (c *Character) RemoveInvocation(invocation string, classType string) error {
+	for i, class := range c.Classes {
+		if !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
+			continue
+		}
+
+		if iClass, ok := c.Classes[i].(InvocationClass); ok {
+			err := iClass.RemoveInvocation(invocation)
+			if err != nil {
+				return fmt.Errorf("Failed to remove invocation '%s':\n%w", invocation, err)
+			}
+		} else {
+			return fmt.Errorf("Class '%s' is not one that implements invocations", c.ClassTypes)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("No classes for character '%s' implement invocations", c.Name)
+}
+
+// Set pact boon for specified class type, if character only has one class a classType is not required
+func (c *Character) SetPactBoon(pactBoon string, classType string) error {
+	for i, class := range c.Classes {
+		if !strings.EqualFold(classType, class.GetClassType()) && len(c.Classes) > 1 {
+			continue
+		}
+
+		if iClass, ok := c.Classes[i].(InvocationClass); ok {
+			err := iClass.SetPactBoon(pactBoon)
+			if err != nil {
+				return fmt.Errorf("Failed to set pact boon to '%s':\n%w", pactBoon, err)
+			}
+		} else {
+			return fmt.Errorf("Class '%s' is not one that implements pact boons", c.ClassTypes)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("No classes for character '%s' implement pact boons", c.Name)
+}
+
 func (c *Character) GetTokenNames() map[string][]string {
 	tokenMap := make(map[string][]string)
 	for i, class := range c.Classes {
@@ -1246,6 +3472,11 @@ func (c *Character) SetLevel(level int) {
 	c.Level = level
 }
 
+// Whether the character knows a spell by name (case insensitive)
+func (c *Character) KnowsSpell(spell string) bool {
+	return c.getSpellIdx(spell) != -1
+}
+
 // Gets index of a given spell by name, returns -1 if no spell matches that name (case insensitive)
 func (c *Character) getSpellIdx(spell string) int {
 	spellIdx := -1
@@ -1,9 +1,13 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/onioncall/dndgo/character-management/shared"
+	"github.com/onioncall/dndgo/dice"
 )
 
 func TestCharacterCalculateAbilitiesFromAdjusted(t *testing.T) {
@@ -376,6 +380,45 @@ func TestCharacterCalculateAC(t *testing.T) {
 			},
 			expected: 16,
 		},
+		{
+			name: "Heavy armor, ring and cloak of protection",
+			character: &Character{
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityDexterity, AbilityModifier: 4},
+				},
+				AC: 0,
+				WornEquipment: shared.WornEquipment{
+					Armor: shared.Armor{
+						Type:       shared.HeavyArmor,
+						Name:       "Chain Mail",
+						Class:      16,
+						Proficient: true,
+					},
+					Ring:  shared.MagicItem{Name: "Ring of Protection", Attuned: true},
+					Cloak: shared.MagicItem{Name: shared.MagicItemCloakOfProtection, Attuned: true},
+				},
+			},
+			expected: 18,
+		},
+		{
+			name: "Heavy armor, ring of protection not attuned",
+			character: &Character{
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityDexterity, AbilityModifier: 4},
+				},
+				AC: 0,
+				WornEquipment: shared.WornEquipment{
+					Armor: shared.Armor{
+						Type:       shared.HeavyArmor,
+						Name:       "Chain Mail",
+						Class:      16,
+						Proficient: true,
+					},
+					Ring: shared.MagicItem{Name: shared.MagicItemRingOfProtection},
+				},
+			},
+			expected: 16,
+		},
 	}
 
 	for _, tt := range tests {
@@ -405,11 +448,11 @@ func TestCharacterCalculateWeaponBonus(t *testing.T) {
 					{Name: shared.AbilityStrength, AbilityModifier: 1},
 				},
 				Weapons: []shared.Weapon{
-					{Name: "Rapier", Bonus: 0, Proficient: true, Properties: []string{shared.WeaponPropertyFinesse}},
+					{Name: "Rapier", Proficient: true, Properties: []string{shared.WeaponPropertyFinesse}},
 				},
 			},
 			expected: []shared.Weapon{
-				{Name: "Rapier", Bonus: 2, Proficient: true, Properties: []string{shared.WeaponPropertyFinesse}},
+				{Name: "Rapier", AttackBonus: 4, DamageBonus: 2, Proficient: true, Properties: []string{shared.WeaponPropertyFinesse}},
 			},
 		},
 		{
@@ -421,11 +464,11 @@ func TestCharacterCalculateWeaponBonus(t *testing.T) {
 					{Name: shared.AbilityStrength, AbilityModifier: 1},
 				},
 				Weapons: []shared.Weapon{
-					{Name: "Rapier", Bonus: 0, Proficient: false, Properties: []string{shared.WeaponPropertyFinesse}},
+					{Name: "Rapier", Proficient: false, Properties: []string{shared.WeaponPropertyFinesse}},
 				},
 			},
 			expected: []shared.Weapon{
-				{Name: "Rapier", Bonus: 2, Proficient: false, Properties: []string{shared.WeaponPropertyFinesse}},
+				{Name: "Rapier", AttackBonus: 2, DamageBonus: 2, Proficient: false, Properties: []string{shared.WeaponPropertyFinesse}},
 			},
 		},
 		{
@@ -437,11 +480,11 @@ func TestCharacterCalculateWeaponBonus(t *testing.T) {
 					{Name: shared.AbilityStrength, AbilityModifier: 3},
 				},
 				Weapons: []shared.Weapon{
-					{Name: "Rapier", Bonus: 0, Proficient: true, Properties: []string{shared.WeaponPropertyFinesse}},
+					{Name: "Rapier", Proficient: true, Properties: []string{shared.WeaponPropertyFinesse}},
 				},
 			},
 			expected: []shared.Weapon{
-				{Name: "Rapier", Bonus: 3, Proficient: true, Properties: []string{shared.WeaponPropertyFinesse}},
+				{Name: "Rapier", AttackBonus: 5, DamageBonus: 3, Proficient: true, Properties: []string{shared.WeaponPropertyFinesse}},
 			},
 		},
 		{
@@ -453,11 +496,11 @@ func TestCharacterCalculateWeaponBonus(t *testing.T) {
 					{Name: shared.AbilityStrength, AbilityModifier: 3},
 				},
 				Weapons: []shared.Weapon{
-					{Name: "Sling", Bonus: 0, Proficient: true, Ranged: true},
+					{Name: "Sling", Proficient: true, Ranged: true},
 				},
 			},
 			expected: []shared.Weapon{
-				{Name: "Sling", Bonus: 2, Proficient: true, Ranged: true},
+				{Name: "Sling", AttackBonus: 4, DamageBonus: 2, Proficient: true, Ranged: true},
 			},
 		},
 		{
@@ -469,11 +512,67 @@ func TestCharacterCalculateWeaponBonus(t *testing.T) {
 					{Name: shared.AbilityStrength, AbilityModifier: 2},
 				},
 				Weapons: []shared.Weapon{
-					{Name: "Club", Bonus: 0, Proficient: true, Ranged: false},
+					{Name: "Club", Proficient: true, Ranged: false},
+				},
+			},
+			expected: []shared.Weapon{
+				{Name: "Club", AttackBonus: 4, DamageBonus: 2, Proficient: true, Ranged: false},
+			},
+		},
+		{
+			name: "Non-proficient melee weapon with custom bonus",
+			character: &Character{
+				Proficiency: 2,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityDexterity, AbilityModifier: 3},
+					{Name: shared.AbilityStrength, AbilityModifier: 2},
+				},
+				Weapons: []shared.Weapon{
+					{Name: "Club +1", CustomBonus: 1, Proficient: false, Ranged: false},
+				},
+			},
+			expected: []shared.Weapon{
+				{Name: "Club +1", AttackBonus: 3, DamageBonus: 3, CustomBonus: 1, Proficient: false, Ranged: false},
+			},
+		},
+		{
+			name: "Dual wielding, off hand damage has no ability modifier",
+			character: &Character{
+				Proficiency: 2,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityDexterity, AbilityModifier: 3},
+					{Name: shared.AbilityStrength, AbilityModifier: 2},
+				},
+				Weapons: []shared.Weapon{
+					{Name: "Handaxe", Proficient: true, Properties: []string{shared.WeaponPropertyLight}},
+					{Name: "Dagger", Proficient: true, Properties: []string{shared.WeaponPropertyFinesse, shared.WeaponPropertyLight}},
+				},
+				PrimaryEquipped:   "Handaxe",
+				SecondaryEquipped: "Dagger",
+			},
+			expected: []shared.Weapon{
+				{Name: "Handaxe", AttackBonus: 4, DamageBonus: 2, Proficient: true, Properties: []string{shared.WeaponPropertyLight}},
+				{Name: "Dagger", AttackBonus: 5, DamageBonus: 0, Proficient: true, Properties: []string{shared.WeaponPropertyFinesse, shared.WeaponPropertyLight}},
+			},
+		},
+		{
+			name: "Dual wielding, off hand keeps a negative ability modifier",
+			character: &Character{
+				Proficiency: 2,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityDexterity, AbilityModifier: 2},
+					{Name: shared.AbilityStrength, AbilityModifier: -1},
+				},
+				Weapons: []shared.Weapon{
+					{Name: "Longsword", Proficient: true, Properties: []string{shared.WeaponPropertyVersatile}},
+					{Name: "Handaxe", Proficient: true, Properties: []string{shared.WeaponPropertyLight}},
 				},
+				PrimaryEquipped:   "Longsword",
+				SecondaryEquipped: "Handaxe",
 			},
 			expected: []shared.Weapon{
-				{Name: "Club", Bonus: 2, Proficient: true, Ranged: false},
+				{Name: "Longsword", AttackBonus: 1, DamageBonus: -1, Proficient: true, Properties: []string{shared.WeaponPropertyVersatile}},
+				{Name: "Handaxe", AttackBonus: 1, DamageBonus: -1, Proficient: true, Properties: []string{shared.WeaponPropertyLight}},
 			},
 		},
 	}
@@ -483,9 +582,13 @@ func TestCharacterCalculateWeaponBonus(t *testing.T) {
 			tt.character.calculateWeaponBonus()
 
 			for i, e := range tt.expected {
-				result := tt.character.Weapons[i].Bonus
-				if e.Bonus != result {
-					t.Errorf("Weapon Bonus '%s'- Expected: %d, Result: %d", e.Name, e.Bonus, result)
+				result := tt.character.Weapons[i]
+				if e.AttackBonus != result.AttackBonus {
+					t.Errorf("Weapon Attack Bonus '%s'- Expected: %d, Result: %d", e.Name, e.AttackBonus, result.AttackBonus)
+				}
+
+				if e.DamageBonus != result.DamageBonus {
+					t.Errorf("Weapon Damage Bonus '%s'- Expected: %d, Result: %d", e.Name, e.DamageBonus, result.DamageBonus)
 				}
 			}
 		})
@@ -579,17 +682,19 @@ func TestCharacterRecover(t *testing.T) {
 		{
 			name: "Recover Health, Spell Slots, Class Detail Slots",
 			character: &Character{
-				HPCurrent:  0,
-				HPMax:      16,
-				ClassTypes: []string{shared.ClassBard},
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				ClassTypes:    []string{shared.ClassBard},
 				SpellSlots: []shared.SpellSlot{
 					{Level: 1, Maximum: 4, Available: 1},
 					{Level: 2, Maximum: 2, Available: 0},
 				},
 			},
 			expected: Character{
-				HPCurrent: 16,
-				HPMax:     16,
+				HPCurrent:     16,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 				SpellSlots: []shared.SpellSlot{
 					{Level: 1, Maximum: 4, Available: 4},
 					{Level: 2, Maximum: 2, Available: 2},
@@ -599,22 +704,45 @@ func TestCharacterRecover(t *testing.T) {
 		{
 			name: "Recover Health, Spell Slots, Multiple Class Detail Slots",
 			character: &Character{
-				HPCurrent: 0,
-				HPMax:     16,
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 				SpellSlots: []shared.SpellSlot{
 					{Level: 1, Maximum: 4, Available: 1},
 					{Level: 2, Maximum: 2, Available: 0},
 				},
 			},
 			expected: Character{
-				HPCurrent: 16,
-				HPMax:     16,
+				HPCurrent:     16,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 				SpellSlots: []shared.SpellSlot{
 					{Level: 1, Maximum: 4, Available: 4},
 					{Level: 2, Maximum: 2, Available: 2},
 				},
 			},
 		},
+		{
+			name: "Dead character, nothing recovered",
+			character: &Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Failures: 3},
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 4, Available: 1},
+				},
+			},
+			expected: Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Failures: 3},
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 4, Available: 1},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -625,6 +753,10 @@ func TestCharacterRecover(t *testing.T) {
 				t.Errorf("HPCurrent- Expected: %d, Result: %d", tt.character.HPCurrent, tt.character.HPCurrent)
 			}
 
+			if tt.character.DeathSaves != tt.expected.DeathSaves {
+				t.Errorf("DeathSaves- Expected: %+v, Result: %+v", tt.expected.DeathSaves, tt.character.DeathSaves)
+			}
+
 			// We should never mutate the max HP
 			if tt.character.HPMax != tt.expected.HPMax {
 				t.Errorf("HPMax- Expected: %d, Result: %d BAAAAD", tt.character.HPMax, tt.character.HPMax)
@@ -768,52 +900,138 @@ func TestCharacterDamageCharacter(t *testing.T) {
 			name:   "Some Damage",
 			damage: 5,
 			character: &Character{
-				HPCurrent: 16,
-				HPMax:     16,
+				HPCurrent:     16,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 			},
 			expected: Character{
-				HPCurrent: 11,
-				HPMax:     16,
+				HPCurrent:     11,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 			},
 		},
 		{
 			name:   "Damage Below Zero",
 			damage: 16,
 			character: &Character{
-				HPCurrent: 11,
-				HPMax:     16,
+				HPCurrent:     11,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 			},
 			expected: Character{
-				HPCurrent: 0,
-				HPMax:     16,
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 			},
 		},
 		{
 			name:   "Temp HP, with remainder",
 			damage: 4,
 			character: &Character{
-				HPCurrent: 11,
-				HPMax:     16,
-				HPTemp:    5,
+				HPCurrent:     11,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				HPTemp:        5,
 			},
 			expected: Character{
-				HPCurrent: 11,
-				HPMax:     16,
-				HPTemp:    1,
+				HPCurrent:     11,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				HPTemp:        1,
 			},
 		},
 		{
 			name:   "Temp HP, with damage left over",
 			damage: 7,
 			character: &Character{
-				HPCurrent: 11,
-				HPMax:     16,
-				HPTemp:    5,
+				HPCurrent:     11,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				HPTemp:        5,
+			},
+			expected: Character{
+				HPCurrent:     9,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				HPTemp:        0,
+			},
+		},
+		{
+			name:   "Massive damage, instant death",
+			damage: 27,
+			character: &Character{
+				HPCurrent:     11,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+			},
+			expected: Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Failures: 3},
+			},
+		},
+		{
+			name:   "Damage at 0 HP, adds a failure",
+			damage: 3,
+			character: &Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Successes: 1, Failures: 1},
+			},
+			expected: Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Successes: 0, Failures: 2},
+			},
+		},
+		{
+			name:   "Damage at 0 HP, stable character starts dying again",
+			damage: 3,
+			character: &Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Successes: 3},
+			},
+			expected: Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Successes: 0, Failures: 1},
+			},
+		},
+		{
+			name:   "Massive damage at 0 HP, instant death",
+			damage: 16,
+			character: &Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 			},
 			expected: Character{
-				HPCurrent: 9,
-				HPMax:     16,
-				HPTemp:    0,
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Failures: 3},
+			},
+		},
+		{
+			name:   "Damage at 0 HP, absorbed by temp HP",
+			damage: 3,
+			character: &Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				HPTemp:        5,
+			},
+			expected: Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				HPTemp:        2,
 			},
 		},
 	}
@@ -832,6 +1050,10 @@ func TestCharacterDamageCharacter(t *testing.T) {
 				t.Errorf("HPTemp- Expected: %d, Result: %d", e.HPTemp, result.HPTemp)
 			}
 
+			if e.DeathSaves != result.DeathSaves {
+				t.Errorf("DeathSaves- Expected: %+v, Result: %+v", e.DeathSaves, result.DeathSaves)
+			}
+
 			// We should never mutate the max HP
 			if e.HPMax != result.HPMax {
 				t.Errorf("HPMax- Expected: %d, Result: %d", e.HPMax, result.HPMax)
@@ -851,24 +1073,59 @@ func TestCharacterHealCharacter(t *testing.T) {
 			name:            "Some Recovery",
 			healthRecovered: 4,
 			character: &Character{
-				HPCurrent: 11,
-				HPMax:     16,
+				HPCurrent:     11,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 			},
 			expected: Character{
-				HPCurrent: 15,
-				HPMax:     16,
+				HPCurrent:     15,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
 			},
 		},
 		{
 			name:            "Greater Than Full Recovery",
 			healthRecovered: 16,
 			character: &Character{
-				HPCurrent: 11,
-				HPMax:     16,
+				HPCurrent:     11,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+			},
+			expected: Character{
+				HPCurrent:     16,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+			},
+		},
+		{
+			name:            "Recovery while dying, death saves reset",
+			healthRecovered: 2,
+			character: &Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Successes: 2, Failures: 1},
+			},
+			expected: Character{
+				HPCurrent:     2,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+			},
+		},
+		{
+			name:            "Dead character, no recovery",
+			healthRecovered: 5,
+			character: &Character{
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Successes: 1, Failures: 3},
 			},
 			expected: Character{
-				HPCurrent: 16,
-				HPMax:     16,
+				HPCurrent:     0,
+				HPMax:         16,
+				HPMaxAdjusted: 16,
+				DeathSaves:    shared.DeathSaves{Successes: 1, Failures: 3},
 			},
 		},
 	}
@@ -883,6 +1140,10 @@ func TestCharacterHealCharacter(t *testing.T) {
 				t.Errorf("HPCurrent- Expected: %d, Result: %d", e.HPCurrent, result.HPCurrent)
 			}
 
+			if e.DeathSaves != result.DeathSaves {
+				t.Errorf("DeathSaves- Expected: %+v, Result: %+v", e.DeathSaves, result.DeathSaves)
+			}
+
 			// We should never mutate the max HP
 			if e.HPMax != result.HPMax {
 				t.Errorf("HPMax- Expected: %d, Result: %d", e.HPMax, result.HPMax)
@@ -891,420 +1152,3945 @@ func TestCharacterHealCharacter(t *testing.T) {
 	}
 }
 
-// TODO: Rename functionality will have to change with the support of multiple character files
-// func TestCharacterRenameCharacter(t *testing.T) {
-// 	tests := []struct {
-// 		name         string
-// 		newName      string
-// 		character    *Character
-// 		expectedName string
-// 	}{
-// 		{
-// 			name:    "Simple Name Change",
-// 			newName: "Aragorn",
-// 			character: &Character{
-// 				Name: "Nim",
-// 			},
-// 			expectedName: "Aragorn",
-// 		},
-// 		{
-// 			name:    "Name With Spaces",
-// 			newName: "Gandalf the Grey",
-// 			character: &Character{
-// 				Name: "Nim",
-// 			},
-// 			expectedName: "Gandalf the Grey",
-// 		},
-// 		{
-// 			name:    "Empty String",
-// 			newName: "",
-// 			character: &Character{
-// 				Name: "Nim",
-// 			},
-// 			expectedName: "",
-// 		},
-// 		{
-// 			name:    "Special Characters",
-// 			newName: "Drizzt Do'Urden",
-// 			character: &Character{
-// 				Name: "Nim",
-// 			},
-// 			expectedName: "Drizzt Do'Urden",
-// 		},
-// 		{
-// 			name:    "Unicode Characters",
-// 			newName: "Søren Kierkegaard",
-// 			character: &Character{
-// 				Name: "Nim",
-// 			},
-// 			expectedName: "Søren Kierkegaard",
-// 		},
-// 	}
-//
-// 	for _, tt := range tests {
-// 		t.Run(tt.name, func(t *testing.T) {
-// 			tt.character.RenameCharacter(tt.newName)
-//
-// 			if tt.character.Name != tt.expectedName {
-// 				t.Errorf("Expected name: %q, Result: %q", tt.expectedName, tt.character.Name)
-// 			}
-// 		})
-// 	}
-// }
-
-func TestCharacterAddEquipment(t *testing.T) {
+func TestCharacterRollDeathSave(t *testing.T) {
 	tests := []struct {
-		name          string
-		character     *Character
-		equipmentType string
-		equipmentName string
-		expected      shared.WornEquipment
+		name      string
+		character Character
+		expectErr bool
 	}{
 		{
-			name:          "Add Cloak",
-			character:     &Character{},
-			equipmentType: "cloak",
-			equipmentName: "cloak of rad shit",
-			expected: shared.WornEquipment{
-				Cloak: "cloak of rad shit",
-			},
+			name:      "Dying character",
+			character: Character{HPCurrent: 0, HPMax: 16, HPMaxAdjusted: 16},
 		},
 		{
-			name: "EquipmentType not valid",
-			character: &Character{
-				WornEquipment: shared.WornEquipment{
-					Cloak: "cloak of rad shit",
-				},
-			},
-			equipmentType: "cloakwef",
-			equipmentName: "cloak of cool shit",
-			expected: shared.WornEquipment{
-				Cloak: "cloak of rad shit",
-			},
+			name:      "Dying character, one save from stable",
+			character: Character{HPCurrent: 0, HPMax: 16, HPMaxAdjusted: 16, DeathSaves: shared.DeathSaves{Successes: 2, Failures: 1}},
+		},
+		{
+			name:      "Character with health",
+			character: Character{HPCurrent: 5, HPMax: 16, HPMaxAdjusted: 16},
+			expectErr: true,
+		},
+		{
+			name:      "Stable character",
+			character: Character{HPCurrent: 0, HPMax: 16, HPMaxAdjusted: 16, DeathSaves: shared.DeathSaves{Successes: 3}},
+			expectErr: true,
+		},
+		{
+			name:      "Dead character",
+			character: Character{HPCurrent: 0, HPMax: 16, HPMaxAdjusted: 16, DeathSaves: shared.DeathSaves{Failures: 3}},
+			expectErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.character.AddEquipment(tt.equipmentType, tt.equipmentName)
+			for range 100 {
+				c := tt.character
+				result, err := c.RollDeathSave()
+
+				if tt.expectErr {
+					if err == nil {
+						t.Fatalf("Error- Expected an error")
+					}
+					return
+				}
 
-			e := tt.expected.Cloak
-			result := tt.character.WornEquipment.Cloak
+				if err != nil {
+					t.Fatalf("Error- Unexpected error: %v", err)
+				}
 
-			if e != result {
-				t.Errorf("Cloak- Expected: %s. Result: %s", e, result)
+				before := tt.character.DeathSaves
+				expected := before
+				expectedHP := 0
+				switch natural := result.Roll.Natural(); {
+				case natural == 20:
+					expected = shared.DeathSaves{}
+					expectedHP = 1
+				case natural == 1:
+					expected.Failures = min(before.Failures+2, shared.DeathSaveThreshold)
+				case natural >= 10:
+					expected.Successes++
+				default:
+					expected.Failures++
+				}
+
+				if expected != c.DeathSaves {
+					t.Errorf("DeathSaves (roll %d)- Expected: %+v, Result: %+v", result.Roll.Natural(), expected, c.DeathSaves)
+				}
+
+				if expectedHP != c.HPCurrent {
+					t.Errorf("HPCurrent (roll %d)- Expected: %d, Result: %d", result.Roll.Natural(), expectedHP, c.HPCurrent)
+				}
+
+				if c.IsStable() != result.Stabilized || c.IsDead() != result.Dead {
+					t.Errorf("Result- Stabilized: %t, Dead: %t, Character Stable: %t, Character Dead: %t",
+						result.Stabilized, result.Dead, c.IsStable(), c.IsDead())
+				}
 			}
 		})
 	}
 }
 
-func TestCharacterRemoveItemFromBackpack(t *testing.T) {
+func TestCharacterCalculateConditions(t *testing.T) {
 	tests := []struct {
-		name      string
-		character *Character
-		itemName  string
-		quantity  int
-		expected  []shared.BackpackItem
+		name          string
+		character     *Character
+		expectedSpeed int
+		expectedHPMax int
+		expectedHP    int
 	}{
 		{
-			name:     "Remove 1 Item",
-			itemName: "soap",
-			quantity: 5,
-			character: &Character{
-				Backpack: []shared.BackpackItem{
-					{Name: "soap", Quantity: 50},
-					{Name: "gold", Quantity: 5},
-				},
-			},
-			expected: []shared.BackpackItem{
-				{Name: "soap", Quantity: 45},
-				{Name: "gold", Quantity: 5},
-			},
+			name:          "No conditions, class speed bonus included",
+			character:     &Character{Speed: 30, SpeedAdjusted: 10, HPCurrent: 20, HPMax: 20},
+			expectedSpeed: 40,
+			expectedHPMax: 20,
+			expectedHP:    20,
 		},
 		{
-			name:     "Remove More Than Available Quantity",
-			itemName: "soap",
-			quantity: 51,
+			name:          "Exhaustion 1, no change",
+			character:     &Character{Speed: 30, HPCurrent: 20, HPMax: 20, Exhaustion: 1},
+			expectedSpeed: 30,
+			expectedHPMax: 20,
+			expectedHP:    20,
+		},
+		{
+			name:          "Exhaustion 2, speed halved including class bonus",
+			character:     &Character{Speed: 30, SpeedAdjusted: 10, HPCurrent: 20, HPMax: 20, Exhaustion: 2},
+			expectedSpeed: 20,
+			expectedHPMax: 20,
+			expectedHP:    20,
+		},
+		{
+			name:          "Exhaustion 4, hp max halved and current hp reduced",
+			character:     &Character{Speed: 30, HPCurrent: 20, HPMax: 21, Exhaustion: 4},
+			expectedSpeed: 15,
+			expectedHPMax: 10,
+			expectedHP:    10,
+		},
+		{
+			name:          "Exhaustion 5, speed reduced to 0",
+			character:     &Character{Speed: 30, SpeedAdjusted: 10, HPCurrent: 5, HPMax: 20, Exhaustion: 5},
+			expectedSpeed: 0,
+			expectedHPMax: 10,
+			expectedHP:    5,
+		},
+		{
+			name:          "Restrained, speed reduced to 0",
+			character:     &Character{Speed: 30, HPCurrent: 20, HPMax: 20, Conditions: []string{shared.ConditionRestrained}},
+			expectedSpeed: 0,
+			expectedHPMax: 20,
+			expectedHP:    20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.character.calculateHPMax()
+			tt.character.calculateSpeed()
+
+			if tt.expectedSpeed != tt.character.SpeedAdjusted {
+				t.Errorf("SpeedAdjusted- Expected: %d, Result: %d", tt.expectedSpeed, tt.character.SpeedAdjusted)
+			}
+
+			if tt.expectedHPMax != tt.character.HPMaxAdjusted {
+				t.Errorf("HPMaxAdjusted- Expected: %d, Result: %d", tt.expectedHPMax, tt.character.HPMaxAdjusted)
+			}
+
+			if tt.expectedHP != tt.character.HPCurrent {
+				t.Errorf("HPCurrent- Expected: %d, Result: %d", tt.expectedHP, tt.character.HPCurrent)
+			}
+		})
+	}
+}
+
+func TestCharacterModifyConditions(t *testing.T) {
+	tests := []struct {
+		name               string
+		character          *Character
+		condition          string
+		remove             bool
+		expectedConditions []string
+		expectedExhaustion int
+		expectedSpeed      int
+		expectErr          bool
+	}{
+		{
+			name:               "Add condition, mixed case",
+			character:          &Character{Speed: 30},
+			condition:          "Poisoned",
+			expectedConditions: []string{"poisoned"},
+			expectedSpeed:      30,
+		},
+		{
+			name:               "Add condition already applied",
+			character:          &Character{Speed: 30, Conditions: []string{"poisoned"}},
+			condition:          "poisoned",
+			expectedConditions: []string{"poisoned"},
+			expectedSpeed:      30,
+		},
+		{
+			name:               "Add restrained, speed updated",
+			character:          &Character{Speed: 30},
+			condition:          "restrained",
+			expectedConditions: []string{"restrained"},
+			expectedSpeed:      0,
+		},
+		{
+			name:               "Remove restrained, speed restored",
+			character:          &Character{Speed: 30, Conditions: []string{"prone", "restrained"}},
+			condition:          "restrained",
+			remove:             true,
+			expectedConditions: []string{"prone"},
+			expectedSpeed:      30,
+		},
+		{
+			name:               "Add exhaustion, level increased",
+			character:          &Character{Speed: 30, Exhaustion: 1},
+			condition:          "exhaustion",
+			expectedConditions: []string{},
+			expectedExhaustion: 2,
+			expectedSpeed:      15,
+		},
+		{
+			name:               "Remove exhaustion, level decreased",
+			character:          &Character{Speed: 30, Exhaustion: 2},
+			condition:          "exhaustion",
+			remove:             true,
+			expectedConditions: []string{},
+			expectedExhaustion: 1,
+			expectedSpeed:      30,
+		},
+		{
+			name:               "Add exhaustion past max level",
+			character:          &Character{Speed: 30, Exhaustion: 6},
+			condition:          "exhaustion",
+			expectedConditions: []string{},
+			expectedExhaustion: 6,
+			expectErr:          true,
+		},
+		{
+			name:               "Unsupported condition",
+			character:          &Character{Speed: 30},
+			condition:          "invisible",
+			expectedConditions: []string{},
+			expectedSpeed:      30,
+			expectErr:          true,
+		},
+		{
+			name:               "Remove condition not applied",
+			character:          &Character{Speed: 30, Conditions: []string{"prone"}},
+			condition:          "poisoned",
+			remove:             true,
+			expectedConditions: []string{"prone"},
+			expectedSpeed:      30,
+			expectErr:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Mirror the character having its stats calculated on load
+			tt.character.calculateSpeed()
+
+			var err error
+			if tt.remove {
+				err = tt.character.RemoveCondition(tt.condition)
+			} else {
+				err = tt.character.AddCondition(tt.condition)
+			}
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error for '%s'", tt.condition)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if len(tt.expectedConditions) != len(tt.character.Conditions) {
+				t.Fatalf("Conditions- Expected: %v, Result: %v", tt.expectedConditions, tt.character.Conditions)
+			}
+
+			for i, e := range tt.expectedConditions {
+				if e != tt.character.Conditions[i] {
+					t.Errorf("Conditions- Expected: %v, Result: %v", tt.expectedConditions, tt.character.Conditions)
+				}
+			}
+
+			if tt.expectedExhaustion != tt.character.Exhaustion {
+				t.Errorf("Exhaustion- Expected: %d, Result: %d", tt.expectedExhaustion, tt.character.Exhaustion)
+			}
+
+			if !tt.expectErr && tt.expectedSpeed != tt.character.SpeedAdjusted {
+				t.Errorf("SpeedAdjusted- Expected: %d, Result: %d", tt.expectedSpeed, tt.character.SpeedAdjusted)
+			}
+		})
+	}
+}
+
+func TestCharacterRollNote(t *testing.T) {
+	tests := []struct {
+		name      string
+		character *Character
+		rollType  string
+		ability   string
+		expected  string
+	}{
+		{
+			name:      "No conditions",
+			character: &Character{},
+			rollType:  shared.RollTypeAttack,
+			expected:  "",
+		},
+		{
+			name:      "Poisoned attack",
+			character: &Character{Conditions: []string{"poisoned", "prone"}},
+			rollType:  shared.RollTypeAttack,
+			expected:  "Disadvantage (poisoned, prone)",
+		},
+		{
+			name:      "Prone does not affect ability checks",
+			character: &Character{Conditions: []string{"prone"}},
+			rollType:  shared.RollTypeAbilityCheck,
+			expected:  "",
+		},
+		{
+			name:      "Exhaustion 1 ability check",
+			character: &Character{Exhaustion: 1},
+			rollType:  shared.RollTypeAbilityCheck,
+			expected:  "Disadvantage (exhaustion 1)",
+		},
+		{
+			name:      "Exhaustion 2 saving throw",
+			character: &Character{Exhaustion: 2},
+			rollType:  shared.RollTypeSavingThrow,
+			expected:  "",
+		},
+		{
+			name:      "Exhaustion 3 saving throw",
+			character: &Character{Exhaustion: 3},
+			rollType:  shared.RollTypeSavingThrow,
+			ability:   shared.AbilityWisdom,
+			expected:  "Disadvantage (exhaustion 3)",
+		},
+		{
+			name:      "Restrained dexterity saving throw",
+			character: &Character{Conditions: []string{"restrained"}},
+			rollType:  shared.RollTypeSavingThrow,
+			ability:   shared.AbilityDexterity,
+			expected:  "Disadvantage (restrained)",
+		},
+		{
+			name:      "Restrained strength saving throw",
+			character: &Character{Conditions: []string{"restrained"}},
+			rollType:  shared.RollTypeSavingThrow,
+			ability:   shared.AbilityStrength,
+			expected:  "",
+		},
+		{
+			name:      "Dwarven Resilience saving throw",
+			character: &Character{RaceTraits: shared.Races[shared.RaceDwarf].Traits},
+			rollType:  shared.RollTypeSavingThrow,
+			ability:   shared.AbilityConstitution,
+			expected:  "Advantage (Dwarven Resilience: poison)",
+		},
+		{
+			name:      "Dwarven Resilience with exhaustion",
+			character: &Character{Exhaustion: 3, RaceTraits: shared.Races[shared.RaceDwarf].Traits},
+			rollType:  shared.RollTypeSavingThrow,
+			ability:   shared.AbilityConstitution,
+			expected:  "Disadvantage (exhaustion 3); Advantage (Dwarven Resilience: poison)",
+		},
+		{
+			name:      "Dwarven Resilience does not affect attacks",
+			character: &Character{RaceTraits: shared.Races[shared.RaceDwarf].Traits},
+			rollType:  shared.RollTypeAttack,
+			expected:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.character.RollNote(tt.rollType, tt.ability)
+
+			if tt.expected != result {
+				t.Errorf("Roll Note- Expected: %q, Result: %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCharacterCastSpell(t *testing.T) {
+	spells := []shared.CharacterSpell{
+		{Name: "Fire Bolt", SlotLevel: 0},
+		{Name: "Bless", SlotLevel: 1, IsConcentration: true},
+		{Name: "Cure Wounds", SlotLevel: 1},
+		{Name: "Hold Person", SlotLevel: 2, IsConcentration: true},
+	}
+
+	tests := []struct {
+		name                  string
+		character             *Character
+		spell                 string
+		slotLevel             int
+		pact                  bool
+		expectedSlots         []shared.SpellSlot
+		expectedConcentration string
+		expectedDropped       string
+		expectErr             bool
+	}{
+		{
+			name: "Cantrip, no slot used",
 			character: &Character{
-				Backpack: []shared.BackpackItem{
-					{Name: "soap", Quantity: 51},
-					{Name: "gold", Quantity: 5},
+				Spells:     spells,
+				SpellSlots: []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 2}},
+			},
+			spell:         "fire bolt",
+			expectedSlots: []shared.SpellSlot{{Level: 1, Available: 2}},
+		},
+		{
+			name: "Concentration spell at its own level",
+			character: &Character{
+				Spells:     spells,
+				SpellSlots: []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 2}},
+			},
+			spell:                 "Bless",
+			expectedSlots:         []shared.SpellSlot{{Level: 1, Available: 1}},
+			expectedConcentration: "Bless",
+		},
+		{
+			name: "Concentration spell replaces the first",
+			character: &Character{
+				Spells:        spells,
+				Concentration: "Bless",
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 2, Available: 2},
+					{Level: 2, Maximum: 2, Available: 2},
 				},
 			},
-			expected: []shared.BackpackItem{
-				{Name: "soap", Quantity: 0},
-				{Name: "gold", Quantity: 5},
+			spell:                 "hold person",
+			expectedSlots:         []shared.SpellSlot{{Level: 1, Available: 2}, {Level: 2, Available: 1}},
+			expectedConcentration: "Hold Person",
+			expectedDropped:       "Bless",
+		},
+		{
+			name: "Non concentration spell keeps concentration, upcast",
+			character: &Character{
+				Spells:        spells,
+				Concentration: "Bless",
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 2, Available: 2},
+					{Level: 2, Maximum: 2, Available: 2},
+				},
 			},
+			spell:                 "Cure Wounds",
+			slotLevel:             2,
+			expectedSlots:         []shared.SpellSlot{{Level: 1, Available: 2}, {Level: 2, Available: 1}},
+			expectedConcentration: "Bless",
 		},
 		{
-			name:     "Item Not In Backpack",
-			itemName: "soapehrgerg",
-			quantity: 50,
+			name: "Slot level below spell level",
 			character: &Character{
-				Backpack: []shared.BackpackItem{
-					{Name: "soap", Quantity: 50},
-					{Name: "gold", Quantity: 5},
+				Spells:     spells,
+				SpellSlots: []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 2}},
+			},
+			spell:         "Hold Person",
+			slotLevel:     1,
+			expectedSlots: []shared.SpellSlot{{Level: 1, Available: 2}},
+			expectErr:     true,
+		},
+		{
+			name: "No slots available, concentration unchanged",
+			character: &Character{
+				Spells:        spells,
+				Concentration: "Hold Person",
+				SpellSlots:    []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 0}},
+			},
+			spell:                 "Bless",
+			expectedSlots:         []shared.SpellSlot{{Level: 1, Available: 0}},
+			expectedConcentration: "Hold Person",
+			expectErr:             true,
+		},
+		{
+			name: "Unknown spell",
+			character: &Character{
+				Spells:     spells,
+				SpellSlots: []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 2}},
+			},
+			spell:         "Fireball",
+			expectedSlots: []shared.SpellSlot{{Level: 1, Available: 2}},
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dropped, err := tt.character.CastSpell(tt.spell, tt.slotLevel, tt.pact, "")
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error casting '%s'", tt.spell)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			for i, e := range tt.expectedSlots {
+				if e.Available != tt.character.SpellSlots[i].Available {
+					t.Errorf("Slot Level %d- Expected: %d, Result: %d", e.Level, e.Available, tt.character.SpellSlots[i].Available)
+				}
+			}
+
+			if tt.expectedConcentration != tt.character.Concentration {
+				t.Errorf("Concentration- Expected: %s, Result: %s", tt.expectedConcentration, tt.character.Concentration)
+			}
+
+			if tt.expectedDropped != dropped {
+				t.Errorf("Dropped- Expected: %s, Result: %s", tt.expectedDropped, dropped)
+			}
+		})
+	}
+}
+
+func TestCharacterDamageCharacterConcentration(t *testing.T) {
+	tests := []struct {
+		name                  string
+		damage                int
+		character             *Character
+		expectedDC            int
+		expectedConcentration string
+	}{
+		{
+			name:   "Small damage, minimum dc",
+			damage: 5,
+			character: &Character{
+				HPCurrent:     30,
+				HPMax:         30,
+				HPMaxAdjusted: 30,
+				Concentration: "Bless",
+			},
+			expectedDC:            10,
+			expectedConcentration: "Bless",
+		},
+		{
+			name:   "Large damage, half the damage",
+			damage: 25,
+			character: &Character{
+				HPCurrent:     30,
+				HPMax:         30,
+				HPMaxAdjusted: 30,
+				Concentration: "Bless",
+			},
+			expectedDC:            12,
+			expectedConcentration: "Bless",
+		},
+		{
+			name:   "Damage absorbed by temp hp still needs a save",
+			damage: 3,
+			character: &Character{
+				HPCurrent:     30,
+				HPMax:         30,
+				HPMaxAdjusted: 30,
+				HPTemp:        5,
+				Concentration: "Bless",
+			},
+			expectedDC:            10,
+			expectedConcentration: "Bless",
+		},
+		{
+			name:   "Dropping to 0 hp ends concentration",
+			damage: 35,
+			character: &Character{
+				HPCurrent:     30,
+				HPMax:         30,
+				HPMaxAdjusted: 30,
+				Concentration: "Bless",
+			},
+			expectedDC:            0,
+			expectedConcentration: "",
+		},
+		{
+			name:   "Not concentrating, no save",
+			damage: 25,
+			character: &Character{
+				HPCurrent:     30,
+				HPMax:         30,
+				HPMaxAdjusted: 30,
+			},
+			expectedDC: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.character.DamageCharacter(tt.damage)
+
+			if tt.expectedDC != tt.character.ConcentrationSaveDC {
+				t.Errorf("ConcentrationSaveDC- Expected: %d, Result: %d", tt.expectedDC, tt.character.ConcentrationSaveDC)
+			}
+
+			if tt.expectedConcentration != tt.character.Concentration {
+				t.Errorf("Concentration- Expected: %s, Result: %s", tt.expectedConcentration, tt.character.Concentration)
+			}
+		})
+	}
+}
+
+func TestCharacterRollConcentrationSave(t *testing.T) {
+	tests := []struct {
+		name      string
+		character Character
+		dc        int
+		expectErr bool
+	}{
+		{
+			name: "Pending dc from damage",
+			character: Character{
+				Concentration:       "Bless",
+				ConcentrationSaveDC: 12,
+				Proficiency:         2,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityConstitution, AbilityModifier: 2, SavingThrowsProficient: true},
 				},
 			},
-			expected: []shared.BackpackItem{
-				{Name: "soap", Quantity: 50},
-				{Name: "gold", Quantity: 5},
+		},
+		{
+			name: "Explicit dc",
+			character: Character{
+				Concentration: "Bless",
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityConstitution, AbilityModifier: 1},
+				},
 			},
+			dc: 15,
+		},
+		{
+			name:      "Not concentrating",
+			character: Character{ConcentrationSaveDC: 12},
+			expectErr: true,
+		},
+		{
+			name:      "No dc",
+			character: Character{Concentration: "Bless"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 50 {
+				c := tt.character
+				result, err := c.RollConcentrationSave(tt.dc)
+
+				if tt.expectErr {
+					if err == nil {
+						t.Fatalf("Error- Expected an error")
+					}
+					return
+				}
+
+				if err != nil {
+					t.Fatalf("Error- Unexpected error: %v", err)
+				}
+
+				expectedDC := tt.dc
+				if expectedDC == 0 {
+					expectedDC = tt.character.ConcentrationSaveDC
+				}
+
+				expectedMod := c.GetSavingThrowMod(shared.AbilityConstitution)
+				if result.Roll.Modifier != expectedMod {
+					t.Errorf("Modifier- Expected: %d, Result: %d", expectedMod, result.Roll.Modifier)
+				}
+
+				maintained := result.Roll.Total >= expectedDC
+				if maintained != result.Maintained {
+					t.Errorf("Maintained (total %d, dc %d)- Expected: %t, Result: %t", result.Roll.Total, expectedDC, maintained, result.Maintained)
+				}
+
+				expectedConcentration := ""
+				if maintained {
+					expectedConcentration = tt.character.Concentration
+				}
+
+				if expectedConcentration != c.Concentration {
+					t.Errorf("Concentration- Expected: %s, Result: %s", expectedConcentration, c.Concentration)
+				}
+
+				if c.ConcentrationSaveDC != 0 {
+					t.Errorf("ConcentrationSaveDC- Expected: 0, Result: %d", c.ConcentrationSaveDC)
+				}
+			}
+		})
+	}
+}
+
+// TODO: Rename functionality will have to change with the support of multiple character files
+// func TestCharacterRenameCharacter(t *testing.T) {
+// 	tests := []struct {
+// 		name         string
+// 		newName      string
+// 		character    *Character
+// 		expectedName string
+// 	}{
+// 		{
+// 			name:    "Simple Name Change",
+// 			newName: "Aragorn",
+// 			character: &Character{
+// 				Name: "Nim",
+// 			},
+// 			expectedName: "Aragorn",
+// 		},
+// 		{
+// 			name:    "Name With Spaces",
+// 			newName: "Gandalf the Grey",
+// 			character: &Character{
+// 				Name: "Nim",
+// 			},
+// 			expectedName: "Gandalf the Grey",
+// 		},
+// 		{
+// 			name:    "Empty String",
+// 			newName: "",
+// 			character: &Character{
+// 				Name: "Nim",
+// 			},
+// 			expectedName: "",
+// 		},
+// 		{
+// 			name:    "Special Characters",
+// 			newName: "Drizzt Do'Urden",
+// 			character: &Character{
+// 				Name: "Nim",
+// 			},
+// 			expectedName: "Drizzt Do'Urden",
+// 		},
+// 		{
+// 			name:    "Unicode Characters",
+// 			newName: "Søren Kierkegaard",
+// 			character: &Character{
+// 				Name: "Nim",
+// 			},
+// 			expectedName: "Søren Kierkegaard",
+// 		},
+// 	}
+//
+// 	for _, tt := range tests {
+// 		t.Run(tt.name, func(t *testing.T) {
+// 			tt.character.RenameCharacter(tt.newName)
+//
+// 			if tt.character.Name != tt.expectedName {
+// 				t.Errorf("Expected name: %q, Result: %q", tt.expectedName, tt.character.Name)
+// 			}
+// 		})
+// 	}
+// }
+
+func TestCharacterAddEquipment(t *testing.T) {
+	tests := []struct {
+		name          string
+		character     *Character
+		equipmentType string
+		equipmentName string
+		weight        float64
+		expected      shared.WornEquipment
+	}{
+		{
+			name:          "Add Cloak",
+			character:     &Character{},
+			equipmentType: "cloak",
+			equipmentName: "cloak of rad shit",
+			weight:        1,
+			expected: shared.WornEquipment{
+				Cloak:   shared.MagicItem{Name: "cloak of rad shit"},
+				Weights: map[string]float64{"cloak": 1},
+			},
+		},
+		{
+			name: "EquipmentType not valid",
+			character: &Character{
+				WornEquipment: shared.WornEquipment{
+					Cloak: shared.MagicItem{Name: "cloak of rad shit"},
+				},
+			},
+			equipmentType: "cloakwef",
+			equipmentName: "cloak of cool shit",
+			weight:        2,
+			expected: shared.WornEquipment{
+				Cloak: shared.MagicItem{Name: "cloak of rad shit"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.character.AddEquipment(tt.equipmentType, tt.equipmentName, tt.weight)
+
+			e := tt.expected.Cloak.Name
+			result := tt.character.WornEquipment.Cloak.Name
+
+			if e != result {
+				t.Errorf("Cloak- Expected: %s. Result: %s", e, result)
+			}
+
+			eWeight := tt.expected.Weights[shared.WornEquipmentCloak]
+			resultWeight := tt.character.WornEquipment.Weights[shared.WornEquipmentCloak]
+
+			if eWeight != resultWeight {
+				t.Errorf("Cloak Weight- Expected: %g, Result: %g", eWeight, resultWeight)
+			}
+		})
+	}
+}
+
+func TestCharacterRemoveItemFromBackpack(t *testing.T) {
+	tests := []struct {
+		name      string
+		character *Character
+		itemName  string
+		quantity  int
+		expected  []shared.BackpackItem
+	}{
+		{
+			name:     "Remove 1 Item",
+			itemName: "soap",
+			quantity: 5,
+			character: &Character{
+				Backpack: []shared.BackpackItem{
+					{Name: "soap", Quantity: 50},
+					{Name: "gold", Quantity: 5},
+				},
+			},
+			expected: []shared.BackpackItem{
+				{Name: "soap", Quantity: 45},
+				{Name: "gold", Quantity: 5},
+			},
+		},
+		{
+			name:     "Remove More Than Available Quantity",
+			itemName: "soap",
+			quantity: 51,
+			character: &Character{
+				Backpack: []shared.BackpackItem{
+					{Name: "soap", Quantity: 51},
+					{Name: "gold", Quantity: 5},
+				},
+			},
+			expected: []shared.BackpackItem{
+				{Name: "soap", Quantity: 0},
+				{Name: "gold", Quantity: 5},
+			},
+		},
+		{
+			name:     "Item Not In Backpack",
+			itemName: "soapehrgerg",
+			quantity: 50,
+			character: &Character{
+				Backpack: []shared.BackpackItem{
+					{Name: "soap", Quantity: 50},
+					{Name: "gold", Quantity: 5},
+				},
+			},
+			expected: []shared.BackpackItem{
+				{Name: "soap", Quantity: 50},
+				{Name: "gold", Quantity: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.character.RemoveItemFromPack(tt.itemName, tt.quantity)
+
+			if len(tt.expected) != len(tt.character.Backpack) {
+				t.Errorf("Item Count- Expected: %d, Result: %d", len(tt.expected), len(tt.character.Backpack))
+			}
+
+			for i, e := range tt.expected {
+				result := tt.character.Backpack[i]
+
+				if e.Quantity != result.Quantity {
+					t.Errorf("Item Quantity %s- Expected: %d, Result: %d", e.Name, e.Quantity, result.Quantity)
+				}
+			}
+		})
+	}
+}
+
+func TestCharacterAddItemToBackpack(t *testing.T) {
+	tests := []struct {
+		name      string
+		character *Character
+		itemName  string
+		quantity  int
+		weight    float64
+		expected  []shared.BackpackItem
+	}{
+		{
+			name:     "Add 1 New Item",
+			itemName: "soap",
+			quantity: 5,
+			character: &Character{
+				Backpack: []shared.BackpackItem{
+					{Name: "gold", Quantity: 5},
+				},
+			},
+			expected: []shared.BackpackItem{
+				{Name: "gold", Quantity: 5},
+				{Name: "soap", Quantity: 5},
+			},
+		},
+		{
+			name:     "Add 1 Existing Item",
+			itemName: "soap",
+			quantity: 5,
+			character: &Character{
+				Backpack: []shared.BackpackItem{
+					{Name: "gold", Quantity: 5},
+					{Name: "soap", Quantity: 5},
+				},
+			},
+			expected: []shared.BackpackItem{
+				{Name: "gold", Quantity: 5},
+				{Name: "soap", Quantity: 10},
+			},
+		},
+		{
+			name:     "Add New Item With Weight",
+			itemName: "rope",
+			quantity: 1,
+			weight:   10,
+			character: &Character{
+				Backpack: []shared.BackpackItem{
+					{Name: "soap", Quantity: 5},
+				},
+			},
+			expected: []shared.BackpackItem{
+				{Name: "soap", Quantity: 5},
+				{Name: "rope", Quantity: 1, Weight: 10},
+			},
+		},
+		{
+			name:     "Existing Item Keeps Weight",
+			itemName: "Torch",
+			quantity: 2,
+			character: &Character{
+				Backpack: []shared.BackpackItem{
+					{Name: "torch", Quantity: 1, Weight: 1},
+				},
+			},
+			expected: []shared.BackpackItem{
+				{Name: "torch", Quantity: 3, Weight: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.character.AddItemToPack(tt.itemName, tt.quantity, tt.weight)
+
+			if len(tt.expected) != len(tt.character.Backpack) {
+				t.Errorf("Item Count- Expected: %d, Result: %d", len(tt.expected), len(tt.character.Backpack))
+			}
+
+			for i, e := range tt.expected {
+				result := tt.character.Backpack[i]
+
+				if e != result {
+					t.Errorf("Item Quantity %s- Expected: %d, Result: %d", e.Name, e.Quantity, result.Quantity)
+				}
+			}
+		})
+	}
+}
+
+func TestCharacterCalculateEncumbrance(t *testing.T) {
+	tests := []struct {
+		name                string
+		character           *Character
+		expectedWeight      float64
+		expectedCapacity    int
+		expectedEncumbrance string
+		expectedSpeed       int
+	}{
+		{
+			name: "Within capacity",
+			character: &Character{
+				Speed: 30,
+				Backpack: []shared.BackpackItem{
+					{Name: "rope", Quantity: 2, Weight: 10},
+					{Name: "soap", Quantity: 1},
+				},
+				WornEquipment: shared.WornEquipment{
+					Weights: map[string]float64{shared.WornEquipmentArmor: 10},
+				},
+				Purse: shared.Purse{Gold: 100},
+			},
+			expectedWeight:      32,
+			expectedCapacity:    150,
+			expectedEncumbrance: shared.EncumbranceNone,
+			expectedSpeed:       30,
+		},
+		{
+			name: "Encumbered ignored without variant rules",
+			character: &Character{
+				Speed: 30,
+				Backpack: []shared.BackpackItem{
+					{Name: "iron pot", Quantity: 1, Weight: 60},
+				},
+			},
+			expectedWeight:      60,
+			expectedCapacity:    150,
+			expectedEncumbrance: shared.EncumbranceNone,
+			expectedSpeed:       30,
+		},
+		{
+			name: "Over capacity",
+			character: &Character{
+				Speed: 30,
+				Backpack: []shared.BackpackItem{
+					{Name: "iron pot", Quantity: 3, Weight: 60},
+				},
+			},
+			expectedWeight:      180,
+			expectedCapacity:    150,
+			expectedEncumbrance: shared.EncumbranceOver,
+			expectedSpeed:       30,
+		},
+		{
+			name: "Variant encumbered",
+			character: &Character{
+				Speed:              30,
+				VariantEncumbrance: true,
+				Backpack: []shared.BackpackItem{
+					{Name: "iron pot", Quantity: 1, Weight: 60},
+				},
+			},
+			expectedWeight:      60,
+			expectedCapacity:    150,
+			expectedEncumbrance: shared.EncumbranceEncumbered,
+			expectedSpeed:       20,
+		},
+		{
+			name: "Variant heavily encumbered",
+			character: &Character{
+				Speed:              30,
+				VariantEncumbrance: true,
+				Backpack: []shared.BackpackItem{
+					{Name: "iron pot", Quantity: 2, Weight: 60},
+				},
+			},
+			expectedWeight:      120,
+			expectedCapacity:    150,
+			expectedEncumbrance: shared.EncumbranceHeavy,
+			expectedSpeed:       10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.character.Abilities = []shared.Ability{
+				{Name: shared.AbilityStrength, Base: 10},
+			}
+			tt.character.CalculateCharacterStats()
+
+			if tt.expectedWeight != tt.character.CarriedWeight {
+				t.Errorf("CarriedWeight- Expected: %g, Result: %g", tt.expectedWeight, tt.character.CarriedWeight)
+			}
+
+			if tt.expectedCapacity != tt.character.CarryingCapacity {
+				t.Errorf("CarryingCapacity- Expected: %d, Result: %d", tt.expectedCapacity, tt.character.CarryingCapacity)
+			}
+
+			if result := tt.character.GetEncumbrance(); tt.expectedEncumbrance != result {
+				t.Errorf("Encumbrance- Expected: %s, Result: %s", tt.expectedEncumbrance, result)
+			}
+
+			if tt.expectedSpeed != tt.character.SpeedAdjusted {
+				t.Errorf("SpeedAdjusted- Expected: %d, Result: %d", tt.expectedSpeed, tt.character.SpeedAdjusted)
+			}
+		})
+	}
+}
+
+func TestCharacterXPProgress(t *testing.T) {
+	tests := []struct {
+		name             string
+		character        *Character
+		expectedLevel    int
+		expectedLevelUp  bool
+		expectedProgress string
+	}{
+		{
+			name:             "Working towards level 2",
+			character:        &Character{Level: 1, XP: 150},
+			expectedLevel:    1,
+			expectedProgress: "150/300",
+		},
+		{
+			name:             "Level up available",
+			character:        &Character{Level: 2, XP: 2700},
+			expectedLevel:    4,
+			expectedLevelUp:  true,
+			expectedProgress: "2700/900 (level up available)",
+		},
+		{
+			name:             "Max level",
+			character:        &Character{Level: 20, XP: 400000},
+			expectedLevel:    20,
+			expectedProgress: "400000 (max level)",
+		},
+		{
+			name:             "Milestone leveling ignores XP",
+			character:        &Character{Level: 1, XP: 900, MilestoneLeveling: true},
+			expectedLevel:    3,
+			expectedProgress: "milestone leveling",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.character.GetLevelFromXP(); tt.expectedLevel != result {
+				t.Errorf("Level From XP- Expected: %d, Result: %d", tt.expectedLevel, result)
+			}
+
+			if result := tt.character.IsLevelUpAvailable(); tt.expectedLevelUp != result {
+				t.Errorf("Level Up Available- Expected: %t, Result: %t", tt.expectedLevelUp, result)
+			}
+
+			if result := tt.character.GetXPProgress(); tt.expectedProgress != result {
+				t.Errorf("XP Progress- Expected: %s, Result: %s", tt.expectedProgress, result)
+			}
+		})
+	}
+}
+
+func TestCharacterAddXP(t *testing.T) {
+	tests := []struct {
+		name      string
+		character *Character
+		xp        int
+		expected  int
+		expectErr bool
+	}{
+		{
+			name:      "Add XP",
+			character: &Character{XP: 100},
+			xp:        250,
+			expected:  350,
+		},
+		{
+			name:      "Remove XP",
+			character: &Character{XP: 100},
+			xp:        -50,
+			expected:  50,
+		},
+		{
+			name:      "Remove more XP than the character has",
+			character: &Character{XP: 100},
+			xp:        -150,
+			expected:  100,
+			expectErr: true,
+		},
+		{
+			name:      "Milestone leveling",
+			character: &Character{MilestoneLeveling: true},
+			xp:        300,
+			expected:  0,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.character.AddXP(tt.xp)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error adding %d XP", tt.xp)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if tt.expected != tt.character.XP {
+				t.Errorf("XP- Expected: %d, Result: %d", tt.expected, tt.character.XP)
+			}
+		})
+	}
+}
+
+func TestCharacterSpendCurrency(t *testing.T) {
+	tests := []struct {
+		name      string
+		purse     shared.Purse
+		cost      shared.Purse
+		expected  shared.Purse
+		expectErr bool
+	}{
+		{
+			name:     "Exact coins",
+			purse:    shared.Purse{Gold: 10, Silver: 5},
+			cost:     shared.Purse{Gold: 3},
+			expected: shared.Purse{Gold: 7, Silver: 5},
+		},
+		{
+			name:     "Smaller coins used when out of the denomination",
+			purse:    shared.Purse{Gold: 2, Silver: 15, Copper: 3},
+			cost:     shared.Purse{Gold: 3},
+			expected: shared.Purse{Silver: 5, Copper: 3},
+		},
+		{
+			name:     "Larger coin broken, change returned",
+			purse:    shared.Purse{Platinum: 1, Copper: 4},
+			cost:     shared.Purse{Gold: 2, Silver: 5},
+			expected: shared.Purse{Gold: 7, Silver: 5, Copper: 4},
+		},
+		{
+			name:     "Smaller coins kept when breaking a larger coin",
+			purse:    shared.Purse{Gold: 1, Silver: 3},
+			cost:     shared.Purse{Silver: 5},
+			expected: shared.Purse{Silver: 8},
+		},
+		{
+			name:     "Exact coins not possible, smallest covering coin broken",
+			purse:    shared.Purse{Electrum: 1, Silver: 1},
+			cost:     shared.Purse{Copper: 15},
+			expected: shared.Purse{Silver: 4, Copper: 5},
+		},
+		{
+			name:      "Not enough money",
+			purse:     shared.Purse{Gold: 1, Silver: 9},
+			cost:      shared.Purse{Gold: 2},
+			expected:  shared.Purse{Gold: 1, Silver: 9},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Character{Purse: tt.purse}
+			err := c.SpendCurrency(tt.cost)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error spending %s", FormatCurrency(tt.cost))
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if tt.expected != c.Purse {
+				t.Errorf("Purse- Expected: %+v, Result: %+v", tt.expected, c.Purse)
+			}
+
+			if !tt.expectErr && GetCurrencyValue(tt.purse)-GetCurrencyValue(tt.cost) != GetCurrencyValue(c.Purse) {
+				t.Errorf("Purse Value- Expected: %d, Result: %d",
+					GetCurrencyValue(tt.purse)-GetCurrencyValue(tt.cost), GetCurrencyValue(c.Purse))
+			}
+		})
+	}
+}
+
+func TestCharacterConvertCurrency(t *testing.T) {
+	tests := []struct {
+		name      string
+		purse     shared.Purse
+		amount    int
+		from      string
+		to        string
+		expected  shared.Purse
+		expectErr bool
+	}{
+		{
+			name:     "Convert up evenly",
+			purse:    shared.Purse{Silver: 30},
+			amount:   20,
+			from:     "sp",
+			to:       "gp",
+			expected: shared.Purse{Gold: 2, Silver: 10},
+		},
+		{
+			name:     "Convert up with change",
+			purse:    shared.Purse{Copper: 250},
+			amount:   250,
+			from:     "cp",
+			to:       "GP",
+			expected: shared.Purse{Gold: 2, Silver: 5},
+		},
+		{
+			name:     "Convert down",
+			purse:    shared.Purse{Platinum: 1},
+			amount:   1,
+			from:     "pp",
+			to:       "ep",
+			expected: shared.Purse{Electrum: 20},
+		},
+		{
+			name:      "Not enough coins",
+			purse:     shared.Purse{Silver: 5},
+			amount:    10,
+			from:      "sp",
+			to:        "gp",
+			expected:  shared.Purse{Silver: 5},
+			expectErr: true,
+		},
+		{
+			name:      "Not enough value for one coin",
+			purse:     shared.Purse{Silver: 5},
+			amount:    5,
+			from:      "sp",
+			to:        "gp",
+			expected:  shared.Purse{Silver: 5},
+			expectErr: true,
+		},
+		{
+			name:      "Invalid denomination",
+			purse:     shared.Purse{Silver: 5},
+			amount:    5,
+			from:      "sp",
+			to:        "doubloons",
+			expected:  shared.Purse{Silver: 5},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Character{Purse: tt.purse}
+			err := c.ConvertCurrency(tt.amount, tt.from, tt.to)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error converting %d %s to %s", tt.amount, tt.from, tt.to)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if tt.expected != c.Purse {
+				t.Errorf("Purse- Expected: %+v, Result: %+v", tt.expected, c.Purse)
+			}
+		})
+	}
+}
+
+func TestParseCurrency(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    string
+		expected  shared.Purse
+		expectErr bool
+	}{
+		{
+			name:     "Single denomination",
+			amount:   "15gp",
+			expected: shared.Purse{Gold: 15},
+		},
+		{
+			name:     "Multiple denominations, spaces and mixed case",
+			amount:   "3 GP, 5sp 2 cp",
+			expected: shared.Purse{Gold: 3, Silver: 5, Copper: 2},
+		},
+		{
+			name:      "Missing denomination",
+			amount:    "15",
+			expectErr: true,
+		},
+		{
+			name:      "Unknown denomination",
+			amount:    "15gp 3 doubloons",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseCurrency(tt.amount)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Error- Expected an error for '%s'", tt.amount)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error- Unexpected error: %v", err)
+			}
+
+			if tt.expected != result {
+				t.Errorf("Purse- Expected: %+v, Result: %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCharacterEquip(t *testing.T) {
+	tests := []struct {
+		name       string
+		character  *Character
+		itemName   string
+		isPrimary  bool
+		ePrimary   string
+		eSecondary string
+	}{
+		{
+			name: "No items equipped, equip primary weapon",
+			character: &Character{
+				PrimaryEquipped:   "",
+				SecondaryEquipped: "",
+				Weapons: []shared.Weapon{
+					{Name: "Rapier"},
+					{Name: "Dagger"},
+					{Name: "Club"},
+				},
+				WornEquipment: shared.WornEquipment{
+					Shield: "Some Shield",
+				},
+			},
+			itemName:   "Rapier",
+			isPrimary:  true,
+			ePrimary:   "Rapier",
+			eSecondary: "",
+		},
+		{
+			name: "No items equipped, equip primary shield",
+			character: &Character{
+				PrimaryEquipped:   "",
+				SecondaryEquipped: "",
+				Weapons: []shared.Weapon{
+					{Name: "Rapier"},
+					{Name: "Dagger"},
+					{Name: "Club"},
+				},
+				WornEquipment: shared.WornEquipment{
+					Shield: "Some Shield",
+				},
+			},
+			itemName:   "Some Shield",
+			isPrimary:  true,
+			ePrimary:   "Some Shield",
+			eSecondary: "",
+		},
+		{
+			name: "No items equipped, equip secondary weapon",
+			character: &Character{
+				PrimaryEquipped:   "",
+				SecondaryEquipped: "",
+				Weapons: []shared.Weapon{
+					{Name: "Rapier"},
+					{Name: "dagger"}, // testing casing
+					{Name: "Club"},
+				},
+				WornEquipment: shared.WornEquipment{
+					Shield: "Some Shield",
+				},
+			},
+			itemName:   "Dagger",
+			isPrimary:  false,
+			ePrimary:   "",
+			eSecondary: "Dagger",
+		},
+		{
+			name: "Primary equipped, equip secondary weapon",
+			character: &Character{
+				PrimaryEquipped:   "Rapier",
+				SecondaryEquipped: "",
+				Weapons: []shared.Weapon{
+					{Name: "Rapier"},
+					{Name: "Dagger"},
+					{Name: "Club"},
+				},
+				WornEquipment: shared.WornEquipment{
+					Shield: "Some Shield",
+				},
+			},
+			itemName:   "Dagger",
+			isPrimary:  false,
+			ePrimary:   "Rapier",
+			eSecondary: "Dagger",
+		},
+		{
+			name: "Both equipped, equip secondary weapon as primary",
+			character: &Character{
+				PrimaryEquipped:   "Rapier",
+				SecondaryEquipped: "Club",
+				Weapons: []shared.Weapon{
+					{Name: "Rapier"},
+					{Name: "Dagger"},
+					{Name: "Club"},
+				},
+				WornEquipment: shared.WornEquipment{
+					Shield: "Some Shield",
+				},
+			},
+			itemName:   "Club",
+			isPrimary:  true,
+			ePrimary:   "Club",
+			eSecondary: "",
+		},
+		{
+			name: "Equipping already equipped primary as secondary",
+			character: &Character{
+				PrimaryEquipped:   "Rapier",
+				SecondaryEquipped: "",
+				Weapons: []shared.Weapon{
+					{Name: "Rapier"},
+					{Name: "Dagger"},
+					{Name: "Club"},
+				},
+				WornEquipment: shared.WornEquipment{
+					Shield: "Some Shield",
+				},
+			},
+			itemName:   "Rapier",
+			isPrimary:  false,
+			ePrimary:   "",
+			eSecondary: "Rapier",
+		},
+		{
+			name: "Equipping weapon we have two of as primary and secondary",
+			character: &Character{
+				PrimaryEquipped:   "Rapier",
+				SecondaryEquipped: "Club",
+				Weapons: []shared.Weapon{
+					{Name: "Rapier"},
+					{Name: "Club"},
+					{Name: "Club"},
+				},
+				WornEquipment: shared.WornEquipment{
+					Shield: "Some Shield",
+				},
+			},
+			itemName:   "Club",
+			isPrimary:  true,
+			ePrimary:   "Club",
+			eSecondary: "Club",
+		},
+		{
+			name: "Equipment not found",
+			character: &Character{
+				PrimaryEquipped:   "",
+				SecondaryEquipped: "",
+				Weapons: []shared.Weapon{
+					{Name: "Rapier"},
+					{Name: "Club"},
+					{Name: "Dagger"},
+				},
+				WornEquipment: shared.WornEquipment{
+					Shield: "Some Shield",
+				},
+			},
+			itemName:   "Longbow",
+			isPrimary:  true,
+			ePrimary:   "",
+			eSecondary: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.character.Equip(tt.isPrimary, tt.itemName)
+
+			rPrimary := tt.character.PrimaryEquipped
+			rSecondary := tt.character.SecondaryEquipped
+
+			if tt.ePrimary != rPrimary {
+				t.Errorf("Primary- Expected: %s, Result: %s", tt.ePrimary, rPrimary)
+			}
+			if tt.eSecondary != rSecondary {
+				t.Errorf("Secondary- Expected: %s, Result: %s", tt.eSecondary, rSecondary)
+			}
+		})
+	}
+}
+
+func TestCharacterAttack(t *testing.T) {
+	tests := []struct {
+		name         string
+		character    *Character
+		weaponName   string
+		advantage    bool
+		disadvantage bool
+		expectErr    bool
+	}{
+		{
+			name: "Attack with weapon, damage type included",
+			character: &Character{
+				Weapons: []shared.Weapon{
+					{Name: "Longsword", AttackBonus: 3, DamageBonus: 3, Damage: "1d8 slashing"},
+				},
+			},
+			weaponName: "longsword",
+		},
+		{
+			name: "Attack with advantage",
+			character: &Character{
+				Weapons: []shared.Weapon{
+					{Name: "Rapier", AttackBonus: 5, DamageBonus: 5, Damage: "1d8"},
+				},
+			},
+			weaponName: "Rapier",
+			advantage:  true,
+		},
+		{
+			name: "Attack with disadvantage",
+			character: &Character{
+				Weapons: []shared.Weapon{
+					{Name: "Longbow", AttackBonus: 2, DamageBonus: 2, Damage: "1d8 piercing"},
+				},
+			},
+			weaponName:   "Longbow",
+			disadvantage: true,
+		},
+		{
+			name: "Weapon not found",
+			character: &Character{
+				Weapons: []shared.Weapon{
+					{Name: "Rapier", AttackBonus: 5, DamageBonus: 5, Damage: "1d8"},
+				},
+			},
+			weaponName: "Dagger",
+			expectErr:  true,
+		},
+		{
+			name: "Weapon has invalid damage",
+			character: &Character{
+				Weapons: []shared.Weapon{
+					{Name: "Net", AttackBonus: 1, DamageBonus: 1, Damage: "special"},
+				},
+			},
+			weaponName: "Net",
+			expectErr:  true,
+		},
+	}
+
+	dice.SetSeed(1)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 100 {
+				result, err := tt.character.Attack(tt.weaponName, tt.advantage, tt.disadvantage)
+				if tt.expectErr {
+					if err == nil {
+						t.Fatalf("Error- Expected an error for '%s'", tt.weaponName)
+					}
+					return
+				}
+
+				if err != nil {
+					t.Fatalf("Error- Unexpected error: %v", err)
+				}
+
+				weapon := tt.character.Weapons[0]
+				natural := result.ToHit.Natural()
+				if natural+weapon.AttackBonus != result.ToHit.Total {
+					t.Errorf("To Hit- Expected: %d, Result: %d", natural+weapon.AttackBonus, result.ToHit.Total)
+				}
+
+				if result.Critical != (natural == 20) {
+					t.Errorf("Critical- Expected: %t, Result: %t", natural == 20, result.Critical)
+				}
+
+				if result.CriticalMiss {
+					if len(result.Damage.Terms) != 0 {
+						t.Errorf("Critical Miss- Expected no damage roll")
+					}
+					continue
+				}
+
+				expectedDice := 1
+				if result.Critical {
+					expectedDice = 2
+				}
+
+				if len(result.Damage.Terms[0].Dice) != expectedDice {
+					t.Errorf("Damage Dice- Expected: %d, Result: %d", expectedDice, len(result.Damage.Terms[0].Dice))
+				}
+
+				if result.Damage.Modifier != weapon.DamageBonus {
+					t.Errorf("Damage Modifier- Expected: %d, Result: %d", weapon.DamageBonus, result.Damage.Modifier)
+				}
+			}
+		})
+	}
+}
+
+// Minimal class for testing character methods that work across classes
+type testClass struct {
+	BaseClass
+}
+
+func (tc *testClass) CalculateHitDice() string {
+	return fmt.Sprintf("%dd%d", tc.Level, tc.GetHitDie())
+}
+
+func (tc *testClass) ClassDetails() string {
+	return ""
+}
+
+// Minimal class with its own pool of slots, like a warlock's Pact Magic
+type testPactClass struct {
+	testClass
+	slot shared.SpellSlot
+}
+
+func (tc *testPactClass) UseSpellSlot(level int) error {
+	if level != 0 && level != tc.slot.Level {
+		return fmt.Errorf("Pact magic slots are level %d, not level %d", tc.slot.Level, level)
+	}
+
+	if tc.slot.Available <= 0 {
+		return fmt.Errorf("No pact magic slots available")
+	}
+
+	tc.slot.Available--
+	return nil
+}
+
+func (tc *testPactClass) RecoverSpellSlots(level int, quantity int) {
+	tc.slot.Available = tc.slot.Maximum
+}
+
+func (tc *testPactClass) GetSpellSlots() []shared.SpellSlot {
+	return []shared.SpellSlot{tc.slot}
+}
+
+func TestCharacterCastSpellPactMagic(t *testing.T) {
+	spells := []shared.CharacterSpell{
+		{Name: "Hex", SlotLevel: 1, IsConcentration: true},
+		{Name: "Hold Person", SlotLevel: 2, IsConcentration: true},
+		{Name: "Fireball", SlotLevel: 3},
+	}
+
+	tests := []struct {
+		name                  string
+		spellSlots            []shared.SpellSlot
+		pactSlot              shared.SpellSlot
+		spell                 string
+		slotLevel             int
+		pact                  bool
+		expectedSlots         []shared.SpellSlot
+		expectedPactAvailable int
+		expectErr             bool
+	}{
+		{
+			name:                  "Spell slots used before pact slots",
+			spellSlots:            []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 2}},
+			pactSlot:              shared.SpellSlot{Level: 2, Maximum: 2, Available: 2},
+			spell:                 "Hex",
+			expectedSlots:         []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 1}},
+			expectedPactAvailable: 2,
+		},
+		{
+			name:                  "Pact slot upcasts once spell slots run out",
+			spellSlots:            []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 0}},
+			pactSlot:              shared.SpellSlot{Level: 2, Maximum: 2, Available: 2},
+			spell:                 "Hex",
+			expectedSlots:         []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 0}},
+			expectedPactAvailable: 1,
+		},
+		{
+			name:                  "Pact slot chosen over spell slots",
+			spellSlots:            []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 2}},
+			pactSlot:              shared.SpellSlot{Level: 2, Maximum: 2, Available: 2},
+			spell:                 "Hex",
+			pact:                  true,
+			expectedSlots:         []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 2}},
+			expectedPactAvailable: 1,
+		},
+		{
+			name:                  "Pact slot too low for the spell",
+			spellSlots:            []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 2}},
+			pactSlot:              shared.SpellSlot{Level: 2, Maximum: 2, Available: 2},
+			spell:                 "Fireball",
+			pact:                  true,
+			expectedSlots:         []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 2}},
+			expectedPactAvailable: 2,
+			expectErr:             true,
+		},
+		{
+			name:                  "Slot level doesn't match the pact slot level",
+			spellSlots:            []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 0}},
+			pactSlot:              shared.SpellSlot{Level: 2, Maximum: 2, Available: 2},
+			spell:                 "Hold Person",
+			slotLevel:             3,
+			expectedSlots:         []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 0}},
+			expectedPactAvailable: 2,
+			expectErr:             true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pactClass := &testPactClass{testClass{BaseClass{ClassType: shared.ClassWarlock, Level: 3}}, tt.pactSlot}
+			character := &Character{
+				Spells:     spells,
+				SpellSlots: tt.spellSlots,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassSorcerer, Level: 1}},
+					pactClass,
+				},
+			}
+
+			_, err := character.CastSpell(tt.spell, tt.slotLevel, tt.pact, "")
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error casting '%s'", tt.spell)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expectedSlots, character.SpellSlots) {
+				t.Errorf("SpellSlots- Expected: %+v, Result: %+v", tt.expectedSlots, character.SpellSlots)
+			}
+
+			if tt.expectedPactAvailable != pactClass.slot.Available {
+				t.Errorf("Pact Slots Available- Expected: %d, Result: %d", tt.expectedPactAvailable, pactClass.slot.Available)
+			}
+		})
+	}
+}
+
+// Minimal class with sorcery points, costs follow the SRD without checking which metamagic is known
+type testSorceryClass struct {
+	testClass
+	points    int
+	maxPoints int
+}
+
+func (tc *testSorceryClass) GetSorceryPoints() int {
+	return tc.points
+}
+
+func (tc *testSorceryClass) SpendSorceryPoints(points int) error {
+	if points > tc.points {
+		return fmt.Errorf("Only %d sorcery points available, %d needed", tc.points, points)
+	}
+
+	tc.points -= points
+	return nil
+}
+
+func (tc *testSorceryClass) GainSorceryPoints(points int) error {
+	if tc.points+points > tc.maxPoints {
+		return fmt.Errorf("Sorcery points can't go above the maximum of %d", tc.maxPoints)
+	}
+
+	tc.points += points
+	return nil
+}
+
+func (tc *testSorceryClass) GetMetamagicCost(metamagic string, spellLevel int) (int, error) {
+	if metamagic == shared.MetamagicTwinned {
+		return max(spellLevel, 1), nil
+	}
+
+	cost, ok := shared.MetamagicCost[metamagic]
+	if !ok {
+		return 0, fmt.Errorf("Metamagic '%s' not found", metamagic)
+	}
+
+	return cost, nil
+}
+
+func TestCharacterFlexibleCasting(t *testing.T) {
+	tests := []struct {
+		name           string
+		spellSlots     []shared.SpellSlot
+		points         int
+		create         bool
+		level          int
+		expectedSlots  []shared.SpellSlot
+		expectedPoints int
+		expectErr      bool
+	}{
+		{
+			name:           "Create a slot above the maximum",
+			spellSlots:     []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 4}},
+			points:         4,
+			create:         true,
+			level:          1,
+			expectedSlots:  []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 5, Created: 1}},
+			expectedPoints: 2,
+		},
+		{
+			name:           "Create a slot at a new level",
+			spellSlots:     []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 4}},
+			points:         5,
+			create:         true,
+			level:          3,
+			expectedSlots:  []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 4}, {Level: 3, Available: 1, Created: 1}},
+			expectedPoints: 0,
+		},
+		{
+			name:           "Not enough sorcery points",
+			spellSlots:     []shared.SpellSlot{{Level: 2, Maximum: 3, Available: 3}},
+			points:         2,
+			create:         true,
+			level:          2,
+			expectedSlots:  []shared.SpellSlot{{Level: 2, Maximum: 3, Available: 3}},
+			expectedPoints: 2,
+			expectErr:      true,
+		},
+		{
+			name:           "Slots above level 5 can't be created",
+			points:         10,
+			create:         true,
+			level:          6,
+			expectedPoints: 10,
+			expectErr:      true,
+		},
+		{
+			name:           "Convert a slot to points",
+			spellSlots:     []shared.SpellSlot{{Level: 2, Maximum: 3, Available: 3}},
+			points:         1,
+			level:          2,
+			expectedSlots:  []shared.SpellSlot{{Level: 2, Maximum: 3, Available: 2}},
+			expectedPoints: 3,
+		},
+		{
+			name:           "Converting can't go above the point maximum",
+			spellSlots:     []shared.SpellSlot{{Level: 3, Maximum: 3, Available: 3}},
+			points:         4,
+			level:          3,
+			expectedSlots:  []shared.SpellSlot{{Level: 3, Maximum: 3, Available: 3}},
+			expectedPoints: 4,
+			expectErr:      true,
+		},
+		{
+			name:           "No slot to convert",
+			spellSlots:     []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 0}},
+			points:         0,
+			level:          1,
+			expectedSlots:  []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 0}},
+			expectedPoints: 0,
+			expectErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorcerer := &testSorceryClass{testClass{BaseClass{ClassType: shared.ClassSorcerer, Level: 5}}, tt.points, 5}
+			character := &Character{
+				SpellSlots: tt.spellSlots,
+				Classes:    []Class{sorcerer},
+			}
+
+			var err error
+			if tt.create {
+				err = character.CreateSpellSlot(tt.level)
+			} else {
+				err = character.ConvertSpellSlot(tt.level)
+			}
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error for a level %d slot", tt.level)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expectedSlots, character.SpellSlots) {
+				t.Errorf("SpellSlots- Expected: %+v, Result: %+v", tt.expectedSlots, character.SpellSlots)
+			}
+
+			if tt.expectedPoints != sorcerer.points {
+				t.Errorf("Sorcery Points- Expected: %d, Result: %d", tt.expectedPoints, sorcerer.points)
+			}
+		})
+	}
+}
+
+func TestCharacterCastSpellMetamagic(t *testing.T) {
+	spells := []shared.CharacterSpell{
+		{Name: "Fire Bolt", SlotLevel: 0},
+		{Name: "Fireball", SlotLevel: 3},
+	}
+
+	tests := []struct {
+		name           string
+		spellSlots     []shared.SpellSlot
+		points         int
+		spell          string
+		metamagic      string
+		expectedSlots  []shared.SpellSlot
+		expectedPoints int
+		expectErr      bool
+	}{
+		{
+			name:           "Twinned cantrip costs 1",
+			points:         3,
+			spell:          "Fire Bolt",
+			metamagic:      shared.MetamagicTwinned,
+			expectedPoints: 2,
+		},
+		{
+			name:           "Twinned spell costs the spell level",
+			spellSlots:     []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 2}},
+			points:         5,
+			spell:          "Fireball",
+			metamagic:      shared.MetamagicTwinned,
+			expectedSlots:  []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 1}},
+			expectedPoints: 2,
+		},
+		{
+			name:           "Created slot used first",
+			spellSlots:     []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 3, Created: 1}},
+			points:         2,
+			spell:          "Fireball",
+			metamagic:      shared.MetamagicQuickened,
+			expectedSlots:  []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 2}},
+			expectedPoints: 0,
+		},
+		{
+			name:           "Points given back without a slot",
+			spellSlots:     []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 0}},
+			points:         2,
+			spell:          "Fireball",
+			metamagic:      shared.MetamagicQuickened,
+			expectedSlots:  []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 0}},
+			expectedPoints: 2,
+			expectErr:      true,
+		},
+		{
+			name:           "Not enough sorcery points",
+			spellSlots:     []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 2}},
+			points:         2,
+			spell:          "Fireball",
+			metamagic:      shared.MetamagicHeightened,
+			expectedSlots:  []shared.SpellSlot{{Level: 3, Maximum: 2, Available: 2}},
+			expectedPoints: 2,
+			expectErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorcerer := &testSorceryClass{testClass{BaseClass{ClassType: shared.ClassSorcerer, Level: 5}}, tt.points, 5}
+			character := &Character{
+				Spells:     spells,
+				SpellSlots: tt.spellSlots,
+				Classes:    []Class{sorcerer},
+			}
+
+			_, err := character.CastSpell(tt.spell, 0, false, tt.metamagic)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error casting '%s'", tt.spell)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expectedSlots, character.SpellSlots) {
+				t.Errorf("SpellSlots- Expected: %+v, Result: %+v", tt.expectedSlots, character.SpellSlots)
+			}
+
+			if tt.expectedPoints != sorcerer.points {
+				t.Errorf("Sorcery Points- Expected: %d, Result: %d", tt.expectedPoints, sorcerer.points)
+			}
+		})
+	}
+}
+
+func TestCharacterShortRest(t *testing.T) {
+	tests := []struct {
+		name          string
+		character     *Character
+		spend         string
+		expectedUsed  []int
+		expectedRolls int
+		expectErr     bool
+	}{
+		{
+			name: "Spend hit dice from single class",
+			character: &Character{
+				HPCurrent:     1,
+				HPMax:         100,
+				HPMaxAdjusted: 100,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 3}},
+				},
+			},
+			spend:         "2d10",
+			expectedUsed:  []int{2},
+			expectedRolls: 1,
+		},
+		{
+			name: "Spend hit dice from multiple classes",
+			character: &Character{
+				HPCurrent:     1,
+				HPMax:         100,
+				HPMaxAdjusted: 100,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 3}},
+					&testClass{BaseClass{ClassType: shared.ClassRogue, Level: 2, HitDiceUsed: 1}},
+				},
+			},
+			spend:         "1d10+1d8",
+			expectedUsed:  []int{1, 2},
+			expectedRolls: 2,
+		},
+		{
+			name: "Spend more hit dice than available",
+			character: &Character{
+				HPCurrent:     1,
+				HPMax:         100,
+				HPMaxAdjusted: 100,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 3, HitDiceUsed: 2}},
+				},
+			},
+			spend:        "2d10",
+			expectedUsed: []int{2},
+			expectErr:    true,
+		},
+		{
+			name: "Spend hit die the class doesn't have",
+			character: &Character{
+				HPCurrent:     1,
+				HPMax:         100,
+				HPMaxAdjusted: 100,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 3}},
+				},
+			},
+			spend:        "1d8",
+			expectedUsed: []int{0},
+			expectErr:    true,
+		},
+		{
+			name: "Rest without spending hit dice",
+			character: &Character{
+				HPCurrent:     1,
+				HPMax:         100,
+				HPMaxAdjusted: 100,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 3}},
+				},
+			},
+			spend:         "",
+			expectedUsed:  []int{0},
+			expectedRolls: 0,
+		},
+	}
+
+	dice.SetSeed(1)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startingHP := tt.character.HPCurrent
+			results, err := tt.character.ShortRest(tt.spend)
+
+			if tt.expectErr != (err != nil) {
+				t.Errorf("Error- Expected: %t, Result: %v", tt.expectErr, err)
+			}
+
+			if tt.expectedRolls != len(results) {
+				t.Errorf("Rolls- Expected: %d, Result: %d", tt.expectedRolls, len(results))
+			}
+
+			healed := 0
+			for _, result := range results {
+				healed += result.Total
+			}
+
+			if startingHP+healed != tt.character.HPCurrent {
+				t.Errorf("HPCurrent- Expected: %d, Result: %d", startingHP+healed, tt.character.HPCurrent)
+			}
+
+			for i, e := range tt.expectedUsed {
+				result := tt.character.Classes[i].GetClassLevel() - tt.character.Classes[i].GetHitDiceAvailable()
+				if e != result {
+					t.Errorf("Hit Dice Used %s- Expected: %d, Result: %d", tt.character.Classes[i].GetClassType(), e, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCharacterRecoverHitDice(t *testing.T) {
+	tests := []struct {
+		name         string
+		character    *Character
+		expectedUsed []int
+	}{
+		{
+			name: "Recover half of total hit dice",
+			character: &Character{
+				Level: 4,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 4, HitDiceUsed: 4}},
+				},
+			},
+			expectedUsed: []int{2},
+		},
+		{
+			name: "Recover at least one hit die",
+			character: &Character{
+				Level: 1,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassWizard, Level: 1, HitDiceUsed: 1}},
+				},
+			},
+			expectedUsed: []int{0},
+		},
+		{
+			name: "Recover hit dice across classes",
+			character: &Character{
+				Level: 6,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 3, HitDiceUsed: 1}},
+					&testClass{BaseClass{ClassType: shared.ClassRogue, Level: 3, HitDiceUsed: 3}},
+				},
+			},
+			expectedUsed: []int{0, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.character.Recover()
+
+			for i, e := range tt.expectedUsed {
+				result := tt.character.Classes[i].GetClassLevel() - tt.character.Classes[i].GetHitDiceAvailable()
+				if e != result {
+					t.Errorf("Hit Dice Used %s- Expected: %d, Result: %d", tt.character.Classes[i].GetClassType(), e, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCharacterLevelUp(t *testing.T) {
+	wizardFeatures := []ClassFeature{
+		{Name: "Arcane Recovery", Level: 1},
+		{Name: "Arcane Tradition", Level: 2},
+		{Name: "Cantrip Formulas", Level: 3},
+	}
+
+	tests := []struct {
+		name               string
+		character          *Character
+		classType          string
+		expectedHPMax      int
+		expectedClassLevel int
+		expectedFeatures   []string
+		expectedASI        bool
+		expectedSubClass   bool
+		expectedSlots      []shared.SpellSlot
+		expectErr          bool
+	}{
+		{
+			name: "Average hp and new spell slots",
+			character: &Character{
+				Level:     2,
+				HPMax:     12,
+				Abilities: []shared.Ability{{Name: shared.AbilityConstitution, AbilityModifier: 2}},
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 3, Available: 1, Expected: 3},
+				},
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassWizard, Level: 2, SubClass: "evocation",
+						OtherFeatures: wizardFeatures}},
+				},
+			},
+			classType:          "Wizard",
+			expectedHPMax:      18,
+			expectedClassLevel: 3,
+			expectedFeatures:   []string{"Cantrip Formulas"},
+			expectedSlots: []shared.SpellSlot{
+				{Level: 1, Maximum: 4, Available: 2, Expected: 4},
+				{Level: 2, Maximum: 2, Available: 2, Expected: 2},
+			},
+		},
+		{
+			name: "Subclass level reached without a subclass",
+			character: &Character{
+				Level: 1,
+				HPMax: 6,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassWizard, Level: 1, OtherFeatures: wizardFeatures}},
+				},
+			},
+			classType:          shared.ClassWizard,
+			expectedHPMax:      10,
+			expectedClassLevel: 2,
+			expectedFeatures:   []string{"Arcane Tradition"},
+			expectedSubClass:   true,
+			expectedSlots: []shared.SpellSlot{
+				{Level: 1, Maximum: 3, Available: 3, Expected: 3},
+			},
+		},
+		{
+			name: "Fighter extra ability score improvement",
+			character: &Character{
+				Level:     5,
+				HPMax:     44,
+				Abilities: []shared.Ability{{Name: shared.AbilityConstitution, AbilityModifier: 3}},
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 5, SubClass: "champion"}},
+				},
+			},
+			classType:          shared.ClassFighter,
+			expectedHPMax:      53,
+			expectedClassLevel: 6,
+			expectedASI:        true,
+		},
+		{
+			name: "Multiclass casters combine caster levels",
+			character: &Character{
+				Level: 4,
+				HPMax: 20,
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 4, Available: 4},
+				},
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassCleric, Level: 2, SubClass: "life"}},
+					&testClass{BaseClass{ClassType: shared.ClassPaladin, Level: 2}},
+				},
+			},
+			classType:          shared.ClassCleric,
+			expectedHPMax:      25,
+			expectedClassLevel: 3,
+			expectedSlots: []shared.SpellSlot{
+				{Level: 1, Maximum: 4, Available: 4, Expected: 4},
+				{Level: 2, Maximum: 3, Available: 3, Expected: 3},
+			},
+		},
+		{
+			name: "Class not found",
+			character: &Character{
+				Level: 1,
+				HPMax: 10,
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 1}},
+				},
+			},
+			classType:     shared.ClassRogue,
+			expectedHPMax: 10,
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.character.LevelUp(tt.classType, false)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Error- Expected an error leveling up class '%s'", tt.classType)
+				}
+			} else if err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if tt.expectedHPMax != tt.character.HPMax {
+				t.Errorf("HPMax- Expected: %d, Result: %d", tt.expectedHPMax, tt.character.HPMax)
+			}
+
+			if tt.expectErr {
+				return
+			}
+
+			if tt.expectedClassLevel != result.ClassLevel {
+				t.Errorf("ClassLevel- Expected: %d, Result: %d", tt.expectedClassLevel, result.ClassLevel)
+			}
+
+			features := []string{}
+			for _, feature := range result.Features {
+				features = append(features, feature.Name)
+			}
+
+			if !slices.Equal(tt.expectedFeatures, features) {
+				t.Errorf("Features- Expected: %v, Result: %v", tt.expectedFeatures, features)
+			}
+
+			if tt.expectedASI != result.AbilityScoreImprovement {
+				t.Errorf("AbilityScoreImprovement- Expected: %t, Result: %t", tt.expectedASI, result.AbilityScoreImprovement)
+			}
+
+			if tt.expectedSubClass != result.SubClassChoice {
+				t.Errorf("SubClassChoice- Expected: %t, Result: %t", tt.expectedSubClass, result.SubClassChoice)
+			}
+
+			if !slices.Equal(tt.expectedSlots, tt.character.SpellSlots) {
+				t.Errorf("SpellSlots- Expected: %+v, Result: %+v", tt.expectedSlots, tt.character.SpellSlots)
+			}
+		})
+	}
+}
+
+func TestCharacterCalculateSpellSlots(t *testing.T) {
+	tests := []struct {
+		name      string
+		character *Character
+		expected  []shared.SpellSlot
+	}{
+		{
+			name: "Single full caster",
+			character: &Character{
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassWizard, Level: 5}},
+				},
+			},
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 4, Available: 4, Expected: 4},
+				{Level: 2, Maximum: 3, Available: 3, Expected: 3},
+				{Level: 3, Maximum: 2, Available: 2, Expected: 2},
+			},
+		},
+		{
+			name: "Single half caster rounds up",
+			character: &Character{
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassPaladin, Level: 5}},
+				},
+			},
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 4, Available: 4, Expected: 4},
+				{Level: 2, Maximum: 2, Available: 2, Expected: 2},
+			},
+		},
+		{
+			name: "Half caster has no slots at level 1",
+			character: &Character{
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassRanger, Level: 1}},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "Multiclass half casters round down",
+			character: &Character{
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassPaladin, Level: 3}},
+					&testClass{BaseClass{ClassType: shared.ClassRanger, Level: 3}},
+				},
+			},
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 3, Available: 3, Expected: 3},
+			},
+		},
+		{
+			name: "Warlock and martial levels don't count",
+			character: &Character{
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 4, Available: 1, Expected: 4},
+					{Level: 2, Maximum: 2, Available: 2, Expected: 2},
+				},
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassSorcerer, Level: 2}},
+					&testClass{BaseClass{ClassType: shared.ClassWarlock, Level: 3}},
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 4}},
+				},
+			},
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 3, Available: 0, Expected: 3},
+				{Level: 2, Maximum: 0, Available: 0, Expected: 0},
+			},
+		},
+		{
+			name: "Manual override is kept",
+			character: &Character{
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 5, Available: 5, Manual: true},
+				},
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassCleric, Level: 3}},
+				},
+			},
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 5, Available: 5, Manual: true, Expected: 4},
+				{Level: 2, Maximum: 2, Available: 2, Expected: 2},
+			},
+		},
+		{
+			name: "Single third caster subclass",
+			character: &Character{
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 2, Available: 2, Expected: 2},
+				},
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 7, SubClass: "Eldritch-Knight"}},
+				},
+			},
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 4, Available: 4, Expected: 4},
+				{Level: 2, Maximum: 2, Available: 2, Expected: 2},
+			},
+		},
+		{
+			name: "Multiclass third caster rounds down",
+			character: &Character{
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassRogue, Level: 5, SubClass: shared.SubClassArcaneTrickster}},
+					&testClass{BaseClass{ClassType: shared.ClassWizard, Level: 1}},
+				},
+			},
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 3, Available: 3, Expected: 3},
+			},
+		},
+		{
+			name: "Non caster keeps hand entered slots as a manual override",
+			character: &Character{
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 2, Available: 2},
+				},
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 3, SubClass: "champion"}},
+				},
+			},
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 2, Available: 2, Manual: true, Expected: 0},
+			},
+		},
+		{
+			name: "Hand entered slot that differs from the table becomes a manual override",
+			character: &Character{
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 3, Available: 3, Expected: 4},
+				},
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassCleric, Level: 3}},
+				},
+			},
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 3, Available: 3, Manual: true, Expected: 4},
+				{Level: 2, Maximum: 2, Available: 2, Expected: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.character.calculateSpellSlots()
+
+			if !slices.Equal(tt.expected, tt.character.SpellSlots) {
+				t.Errorf("SpellSlots- Expected: %+v, Result: %+v", tt.expected, tt.character.SpellSlots)
+			}
+		})
+	}
+}
+
+func TestCharacterClearSpellSlotOverride(t *testing.T) {
+	tests := []struct {
+		name      string
+		character *Character
+		level     int
+		expected  []shared.SpellSlot
+		expectErr bool
+	}{
+		{
+			name: "Override cleared",
+			character: &Character{
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 5, Available: 5, Manual: true, Expected: 4},
+				},
+			},
+			level: 1,
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 4, Available: 4, Expected: 4},
+			},
+		},
+		{
+			name: "Slot without an override",
+			character: &Character{
+				SpellSlots: []shared.SpellSlot{
+					{Level: 1, Maximum: 4, Available: 2, Expected: 4},
+				},
+			},
+			level: 1,
+			expected: []shared.SpellSlot{
+				{Level: 1, Maximum: 4, Available: 2, Expected: 4},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.character.ClearSpellSlotOverride(tt.level)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error clearing slot level %d", tt.level)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expected, tt.character.SpellSlots) {
+				t.Errorf("SpellSlots- Expected: %+v, Result: %+v", tt.expected, tt.character.SpellSlots)
+			}
+		})
+	}
+}
+
+func TestCharacterApplyAbilityScoreImprovement(t *testing.T) {
+	tests := []struct {
+		name      string
+		abilities []string
+		expected  []shared.AbilityScoreImprovementItem
+		expectErr bool
+	}{
+		{
+			name:      "One ability gets +2",
+			abilities: []string{"Strength"},
+			expected: []shared.AbilityScoreImprovementItem{
+				{Ability: shared.AbilityStrength, Bonus: 2},
+			},
+		},
+		{
+			name:      "Two abilities get +1",
+			abilities: []string{"strength", " dexterity"},
+			expected: []shared.AbilityScoreImprovementItem{
+				{Ability: shared.AbilityStrength, Bonus: 1},
+				{Ability: shared.AbilityDexterity, Bonus: 1},
+			},
+		},
+		{
+			name:      "Invalid ability",
+			abilities: []string{"strength", "luck"},
+			expected:  []shared.AbilityScoreImprovementItem{},
+			expectErr: true,
+		},
+		{
+			name:      "Too many abilities",
+			abilities: []string{"strength", "dexterity", "wisdom"},
+			expected:  []shared.AbilityScoreImprovementItem{},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Character{AbilityScoreImprovement: []shared.AbilityScoreImprovementItem{}}
+			err := c.ApplyAbilityScoreImprovement(tt.abilities)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error applying %v", tt.abilities)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expected, c.AbilityScoreImprovement) {
+				t.Errorf("AbilityScoreImprovement- Expected: %+v, Result: %+v", tt.expected, c.AbilityScoreImprovement)
+			}
+		})
+	}
+}
+
+// Minimal class with a once per day slot recovery, like a wizard's Arcane Recovery
+type testRecoveryClass struct {
+	testClass
+	used bool
+}
+
+func (tc *testRecoveryClass) GetSlotRecoveryLevels() int {
+	return (tc.Level + 1) / 2
+}
+
+func (tc *testRecoveryClass) UseSlotRecovery() error {
+	if tc.used {
+		return fmt.Errorf("Arcane Recovery has already been used today")
+	}
+
+	tc.used = true
+	return nil
+}
+
+func TestCharacterArcaneRecovery(t *testing.T) {
+	tests := []struct {
+		name          string
+		spellSlots    []shared.SpellSlot
+		level         int
+		used          bool
+		slotLevels    string
+		expectedSlots []shared.SpellSlot
+		expectErr     bool
+	}{
+		{
+			name:          "Recover two level 1 slots",
+			spellSlots:    []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 1}, {Level: 2, Maximum: 2, Available: 0}},
+			level:         4,
+			slotLevels:    "1,1",
+			expectedSlots: []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 3}, {Level: 2, Maximum: 2, Available: 0}},
+		},
+		{
+			name:          "Half the level rounds up",
+			spellSlots:    []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 0}, {Level: 2, Maximum: 2, Available: 1}},
+			level:         5,
+			slotLevels:    "2, 1",
+			expectedSlots: []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 1}, {Level: 2, Maximum: 2, Available: 2}},
+		},
+		{
+			name:          "Combined level too high",
+			spellSlots:    []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 0}, {Level: 2, Maximum: 2, Available: 0}},
+			level:         4,
+			slotLevels:    "2,1",
+			expectedSlots: []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 0}, {Level: 2, Maximum: 2, Available: 0}},
+			expectErr:     true,
+		},
+		{
+			name:          "Slot isn't expended",
+			spellSlots:    []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 3}},
+			level:         4,
+			slotLevels:    "1,1",
+			expectedSlots: []shared.SpellSlot{{Level: 1, Maximum: 4, Available: 3}},
+			expectErr:     true,
+		},
+		{
+			name:          "Level 6 slots can't be recovered",
+			spellSlots:    []shared.SpellSlot{{Level: 6, Maximum: 1, Available: 0}},
+			level:         20,
+			slotLevels:    "6",
+			expectedSlots: []shared.SpellSlot{{Level: 6, Maximum: 1, Available: 0}},
+			expectErr:     true,
+		},
+		{
+			name:          "Already used today",
+			spellSlots:    []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 0}},
+			level:         2,
+			used:          true,
+			slotLevels:    "1",
+			expectedSlots: []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 0}},
+			expectErr:     true,
+		},
+		{
+			name:          "Invalid slot level",
+			spellSlots:    []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 0}},
+			level:         2,
+			slotLevels:    "one",
+			expectedSlots: []shared.SpellSlot{{Level: 1, Maximum: 2, Available: 0}},
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wizard := &testRecoveryClass{testClass{BaseClass{ClassType: shared.ClassWizard, Level: tt.level}}, tt.used}
+			character := &Character{
+				SpellSlots: tt.spellSlots,
+				Classes:    []Class{wizard},
+			}
+
+			err := character.ArcaneRecovery(tt.slotLevels)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error recovering '%s'", tt.slotLevels)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expectedSlots, character.SpellSlots) {
+				t.Errorf("SpellSlots- Expected: %+v, Result: %+v", tt.expectedSlots, character.SpellSlots)
+			}
+
+			if !tt.expectErr && !wizard.used {
+				t.Errorf("Used- Expected: %t, Result: %t", true, wizard.used)
+			}
+		})
+	}
+}
+
+// Minimal class that prepares spells up to a fixed maximum
+type testPreparedClass struct {
+	testClass
+	preparedSpells    []string
+	preparedSpellsMax int
+}
+
+func (tc *testPreparedClass) AddPreparedSpell(spell string) error {
+	tc.preparedSpells = append(tc.preparedSpells, spell)
+	return nil
+}
+
+func (tc *testPreparedClass) RemovePreparedSpell(spell string) error {
+	tc.preparedSpells = slices.DeleteFunc(tc.preparedSpells, func(s string) bool { return s == spell })
+	return nil
+}
+
+func (tc *testPreparedClass) GetPreparedSpells() []string {
+	return tc.preparedSpells
+}
+
+func (tc *testPreparedClass) GetPreparedSpellsMax() int {
+	return tc.preparedSpellsMax
+}
+
+func TestCharacterAddPreparedSpell(t *testing.T) {
+	tests := []struct {
+		name               string
+		preparedSpells     []string
+		preparedSpellsMax  int
+		validationDisabled bool
+		spell              string
+		expected           []string
+		expectErr          bool
+	}{
+		{
+			name:              "Prepare a spell under the maximum",
+			preparedSpells:    []string{"Bless"},
+			preparedSpellsMax: 2,
+			spell:             "Cure Wounds",
+			expected:          []string{"Bless", "Cure Wounds"},
+		},
+		{
+			name:              "Prepared spell maximum reached",
+			preparedSpells:    []string{"Bless", "Cure Wounds"},
+			preparedSpellsMax: 2,
+			spell:             "Guiding Bolt",
+			expected:          []string{"Bless", "Cure Wounds"},
+			expectErr:         true,
+		},
+		{
+			name:               "Validation disabled allows going over the maximum",
+			preparedSpells:     []string{"Bless", "Cure Wounds"},
+			preparedSpellsMax:  2,
+			validationDisabled: true,
+			spell:              "Guiding Bolt",
+			expected:           []string{"Bless", "Cure Wounds", "Guiding Bolt"},
+		},
+		{
+			name:              "Spell not in the spell list",
+			preparedSpellsMax: 2,
+			spell:             "Fireball",
+			expected:          nil,
+			expectErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleric := &testPreparedClass{testClass{BaseClass{ClassType: shared.ClassCleric, Level: 3}}, tt.preparedSpells, tt.preparedSpellsMax}
+			character := &Character{
+				ValidationDisabled: tt.validationDisabled,
+				Spells: []shared.CharacterSpell{
+					{Name: "Bless", SlotLevel: 1},
+					{Name: "Cure Wounds", SlotLevel: 1},
+					{Name: "Guiding Bolt", SlotLevel: 1},
+				},
+				Classes: []Class{cleric},
+			}
+
+			err := character.AddPreparedSpell(tt.spell, "")
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error preparing '%s'", tt.spell)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expected, cleric.preparedSpells) {
+				t.Errorf("Prepared Spells- Expected: %v, Result: %v", tt.expected, cleric.preparedSpells)
+			}
+		})
+	}
+}
+
+// Minimal class with fixed spells and cantrips known
+type testKnownClass struct {
+	testClass
+	spellsKnown   int
+	cantripsKnown int
+	spellSwap     bool
+}
+
+func (tc *testKnownClass) GetSpellsKnown() int {
+	return tc.spellsKnown
+}
+
+func (tc *testKnownClass) GetCantripsKnown() int {
+	return tc.cantripsKnown
+}
+
+func (tc *testKnownClass) GetSpellSwap() bool {
+	return tc.spellSwap
+}
+
+func (tc *testKnownClass) SetSpellSwap(available bool) {
+	tc.spellSwap = available
+}
+
+func TestCharacterAddSpell(t *testing.T) {
+	tests := []struct {
+		name               string
+		spells             []shared.CharacterSpell
+		validationDisabled bool
+		multiclass         bool
+		classType          string
+		spell              shared.CharacterSpell
+		expected           []shared.CharacterSpell
+		expectErr          bool
+	}{
+		{
+			name:     "Learn a spell under the maximum",
+			spells:   []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1}},
+			spell:    shared.CharacterSpell{Name: "Vicious Mockery", SlotLevel: 0},
+			expected: []shared.CharacterSpell{{Name: "Vicious Mockery", SlotLevel: 0, ClassType: shared.ClassBard}, {Name: "Sleep", SlotLevel: 1}},
+		},
+		{
+			name:      "Spells known maximum reached",
+			spells:    []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1}, {Name: "Charm Person", SlotLevel: 1, ClassType: shared.ClassBard}},
+			spell:     shared.CharacterSpell{Name: "Heroism", SlotLevel: 1},
+			expected:  []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1}, {Name: "Charm Person", SlotLevel: 1, ClassType: shared.ClassBard}},
+			expectErr: true,
+		},
+		{
+			name:      "Cantrips known maximum reached",
+			spells:    []shared.CharacterSpell{{Name: "Minor Illusion", SlotLevel: 0}},
+			spell:     shared.CharacterSpell{Name: "Vicious Mockery", SlotLevel: 0},
+			expected:  []shared.CharacterSpell{{Name: "Minor Illusion", SlotLevel: 0}},
+			expectErr: true,
+		},
+		{
+			name:               "Validation disabled allows going over the maximum",
+			spells:             []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1}, {Name: "Charm Person", SlotLevel: 1}},
+			validationDisabled: true,
+			spell:              shared.CharacterSpell{Name: "Heroism", SlotLevel: 1},
+			expected:           []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1}, {Name: "Charm Person", SlotLevel: 1}, {Name: "Heroism", SlotLevel: 1, ClassType: shared.ClassBard}},
+		},
+		{
+			name:      "Spell already known",
+			spells:    []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1}},
+			spell:     shared.CharacterSpell{Name: "sleep", SlotLevel: 1},
+			expected:  []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1}},
+			expectErr: true,
+		},
+		{
+			name:       "Multiclass learns a spell for the given class",
+			spells:     []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1, ClassType: shared.ClassBard}},
+			multiclass: true,
+			classType:  shared.ClassSorcerer,
+			spell:      shared.CharacterSpell{Name: "Fireball", SlotLevel: 3},
+			expected:   []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1, ClassType: shared.ClassBard}, {Name: "Fireball", SlotLevel: 3, ClassType: shared.ClassSorcerer}},
+		},
+		{
+			name:       "Multiclass without a class type",
+			spells:     []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1, ClassType: shared.ClassBard}},
+			multiclass: true,
+			spell:      shared.CharacterSpell{Name: "Fireball", SlotLevel: 3},
+			expected:   []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1, ClassType: shared.ClassBard}},
+			expectErr:  true,
+		},
+		{
+			name:       "Multiclass with a misspelled class type",
+			spells:     []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1, ClassType: shared.ClassBard}},
+			multiclass: true,
+			classType:  "sorceror",
+			spell:      shared.CharacterSpell{Name: "Fireball", SlotLevel: 3},
+			expected:   []shared.CharacterSpell{{Name: "Sleep", SlotLevel: 1, ClassType: shared.ClassBard}},
+			expectErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bard := &testKnownClass{testClass{BaseClass{ClassType: shared.ClassBard, Level: 1}}, 2, 1, false}
+			character := &Character{
+				ValidationDisabled: tt.validationDisabled,
+				Spells:             tt.spells,
+				Classes:            []Class{bard},
+			}
+			if tt.multiclass {
+				sorcerer := &testKnownClass{testClass{BaseClass{ClassType: shared.ClassSorcerer, Level: 5}}, 6, 4, false}
+				character.Classes = append(character.Classes, sorcerer)
+			}
+
+			err := character.AddSpell(tt.spell, tt.classType)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error adding '%s'", tt.spell.Name)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expected, character.Spells) {
+				t.Errorf("Spells- Expected: %+v, Result: %+v", tt.expected, character.Spells)
+			}
+		})
+	}
+}
+
+func TestCharacterSwapKnownSpell(t *testing.T) {
+	tests := []struct {
+		name         string
+		spellSwap    bool
+		oldSpell     string
+		newSpell     shared.CharacterSpell
+		expected     []shared.CharacterSpell
+		expectedSwap bool
+		expectErr    bool
+	}{
+		{
+			name:         "Swap a known spell",
+			spellSwap:    true,
+			oldSpell:     "sleep",
+			newSpell:     shared.CharacterSpell{Name: "Hold Person", SlotLevel: 2},
+			expected:     []shared.CharacterSpell{{Name: "Vicious Mockery", SlotLevel: 0}, {Name: "Hold Person", SlotLevel: 2, ClassType: shared.ClassBard}},
+			expectedSwap: false,
+		},
+		{
+			name:         "No swap available",
+			oldSpell:     "Sleep",
+			newSpell:     shared.CharacterSpell{Name: "Hold Person", SlotLevel: 2},
+			expected:     []shared.CharacterSpell{{Name: "Vicious Mockery", SlotLevel: 0}, {Name: "Sleep", SlotLevel: 1}},
+			expectedSwap: false,
+			expectErr:    true,
+		},
+		{
+			name:         "Cantrips can't be swapped",
+			spellSwap:    true,
+			oldSpell:     "Vicious Mockery",
+			newSpell:     shared.CharacterSpell{Name: "Minor Illusion", SlotLevel: 0},
+			expected:     []shared.CharacterSpell{{Name: "Vicious Mockery", SlotLevel: 0}, {Name: "Sleep", SlotLevel: 1}},
+			expectedSwap: true,
+			expectErr:    true,
+		},
+		{
+			name:         "Spell isn't known",
+			spellSwap:    true,
+			oldSpell:     "Fireball",
+			newSpell:     shared.CharacterSpell{Name: "Hold Person", SlotLevel: 2},
+			expected:     []shared.CharacterSpell{{Name: "Vicious Mockery", SlotLevel: 0}, {Name: "Sleep", SlotLevel: 1}},
+			expectedSwap: true,
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bard := &testKnownClass{testClass{BaseClass{ClassType: shared.ClassBard, Level: 3}}, 6, 2, tt.spellSwap}
+			character := &Character{
+				Spells:  []shared.CharacterSpell{{Name: "Vicious Mockery", SlotLevel: 0}, {Name: "Sleep", SlotLevel: 1}},
+				Classes: []Class{bard},
+			}
+
+			err := character.SwapKnownSpell(tt.oldSpell, tt.newSpell, "")
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error swapping '%s'", tt.oldSpell)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expected, character.Spells) {
+				t.Errorf("Spells- Expected: %+v, Result: %+v", tt.expected, character.Spells)
+			}
+
+			if tt.expectedSwap != bard.spellSwap {
+				t.Errorf("Spell Swap- Expected: %t, Result: %t", tt.expectedSwap, bard.spellSwap)
+			}
+		})
+	}
+}
+
+func TestCharacterSetSpellcasting(t *testing.T) {
+	tests := []struct {
+		name             string
+		classAbilities   [][2]string
+		spell            shared.CharacterSpell
+		expectedDC       int
+		expectedAttack   int
+		expectedSpellDC  int
+		expectedSpellMod int
+	}{
+		{
+			name:             "Single class",
+			classAbilities:   [][2]string{{shared.ClassCleric, shared.AbilityWisdom}},
+			spell:            shared.CharacterSpell{Name: "Bless"},
+			expectedDC:       13,
+			expectedAttack:   5,
+			expectedSpellDC:  13,
+			expectedSpellMod: 5,
+		},
+		{
+			name:             "Multiclass keeps the best stats, spells use their class",
+			classAbilities:   [][2]string{{shared.ClassWizard, shared.AbilityIntelligence}, {shared.ClassCleric, shared.AbilityWisdom}},
+			spell:            shared.CharacterSpell{Name: "Bless", ClassType: shared.ClassCleric},
+			expectedDC:       15,
+			expectedAttack:   7,
+			expectedSpellDC:  13,
+			expectedSpellMod: 5,
+		},
+		{
+			name:             "Multiclass spell without a class uses the best stats",
+			classAbilities:   [][2]string{{shared.ClassCleric, shared.AbilityWisdom}, {shared.ClassWizard, shared.AbilityIntelligence}},
+			spell:            shared.CharacterSpell{Name: "Shield"},
+			expectedDC:       15,
+			expectedAttack:   7,
+			expectedSpellDC:  15,
+			expectedSpellMod: 7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &Character{
+				Proficiency: 2,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityWisdom, AbilityModifier: 3},
+					{Name: shared.AbilityIntelligence, AbilityModifier: 5},
+				},
+			}
+
+			for _, ca := range tt.classAbilities {
+				character.SetSpellcasting(ca[0], ca[1])
+			}
+
+			if tt.expectedDC != character.SpellSaveDC {
+				t.Errorf("Spell Save DC- Expected: %d, Result: %d", tt.expectedDC, character.SpellSaveDC)
+			}
+
+			if tt.expectedAttack != character.SpellAttackMod {
+				t.Errorf("Spell Attack Mod- Expected: %d, Result: %d", tt.expectedAttack, character.SpellAttackMod)
+			}
+
+			stats := character.GetSpellStats(tt.spell)
+			if tt.expectedSpellDC != stats.SpellSaveDC {
+				t.Errorf("Spell '%s' Save DC- Expected: %d, Result: %d", tt.spell.Name, tt.expectedSpellDC, stats.SpellSaveDC)
+			}
+
+			if tt.expectedSpellMod != stats.SpellAttackMod {
+				t.Errorf("Spell '%s' Attack Mod- Expected: %d, Result: %d", tt.spell.Name, tt.expectedSpellMod, stats.SpellAttackMod)
+			}
+		})
+	}
+}
+
+func TestRaceExecutePreCalculateMethods(t *testing.T) {
+	tests := []struct {
+		name               string
+		race               string
+		subrace            string
+		speed              int
+		mode               string
+		bonuses            []shared.AbilityScoreImprovementItem
+		expectedAbilities  map[string]int
+		expectedSpeed      int
+		expectedDarkvision int
+		expectedLanguages  []string
+	}{
+		{
+			name:               "Bonuses already included",
+			race:               shared.RaceDwarf,
+			subrace:            "hill",
+			expectedAbilities:  map[string]int{shared.AbilityConstitution: 10, shared.AbilityWisdom: 10},
+			expectedSpeed:      25,
+			expectedDarkvision: 60,
+			expectedLanguages:  []string{"Common", "Dwarvish"},
+		},
+		{
+			name:               "Fixed bonuses with subrace",
+			race:               shared.RaceDwarf,
+			subrace:            "hill",
+			mode:               shared.RacialBonusFixed,
+			expectedAbilities:  map[string]int{shared.AbilityConstitution: 12, shared.AbilityWisdom: 11},
+			expectedSpeed:      25,
+			expectedDarkvision: 60,
+			expectedLanguages:  []string{"Common", "Dwarvish"},
+		},
+		{
+			name:               "Fixed bonuses with choices",
+			race:               shared.RaceHalfElf,
+			mode:               shared.RacialBonusFixed,
+			bonuses:            []shared.AbilityScoreImprovementItem{{Ability: shared.AbilityDexterity, Bonus: 1}, {Ability: shared.AbilityWisdom, Bonus: 1}},
+			expectedAbilities:  map[string]int{shared.AbilityCharisma: 12, shared.AbilityDexterity: 11, shared.AbilityWisdom: 11},
+			expectedSpeed:      30,
+			expectedDarkvision: 60,
+			expectedLanguages:  []string{"Common", "Elvish"},
+		},
+		{
+			name:               "Flexible bonuses replace racial bonuses",
+			race:               shared.RaceElf,
+			subrace:            "wood",
+			mode:               shared.RacialBonusFlexible,
+			bonuses:            []shared.AbilityScoreImprovementItem{{Ability: shared.AbilityWisdom, Bonus: 2}, {Ability: shared.AbilityConstitution, Bonus: 1}},
+			expectedAbilities:  map[string]int{shared.AbilityDexterity: 10, shared.AbilityWisdom: 12, shared.AbilityConstitution: 11},
+			expectedSpeed:      35,
+			expectedDarkvision: 60,
+			expectedLanguages:  []string{"Common", "Elvish"},
+		},
+		{
+			name:               "Character speed takes priority",
+			race:               shared.RaceHalfling,
+			speed:              30,
+			expectedAbilities:  map[string]int{shared.AbilityDexterity: 10},
+			expectedSpeed:      30,
+			expectedDarkvision: 0,
+			expectedLanguages:  []string{"Common", "Halfling"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &Character{
+				Race:                 tt.race,
+				Subrace:              tt.subrace,
+				Speed:                tt.speed,
+				RacialBonusMode:      tt.mode,
+				RacialAbilityBonuses: tt.bonuses,
+				Languages:            []string{"Common"},
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityDexterity, Base: 10},
+					{Name: shared.AbilityConstitution, Base: 10},
+					{Name: shared.AbilityWisdom, Base: 10},
+					{Name: shared.AbilityCharisma, Base: 10},
+				},
+			}
+
+			race := character.GetRace()
+			if race == nil {
+				t.Fatalf("Race '%s' not found", tt.race)
+			}
+
+			race.ExecutePreCalculateMethods(character)
+			character.calculateAdjustedAbilities()
+			character.calculateSpeed()
+
+			for _, a := range character.Abilities {
+				expected, ok := tt.expectedAbilities[a.Name]
+				if ok && expected != a.Adjusted {
+					t.Errorf("%s- Expected: %d, Result: %d", a.Name, expected, a.Adjusted)
+				}
+			}
+
+			if tt.expectedSpeed != character.SpeedAdjusted {
+				t.Errorf("Speed- Expected: %d, Result: %d", tt.expectedSpeed, character.SpeedAdjusted)
+			}
+
+			if tt.expectedDarkvision != character.Darkvision {
+				t.Errorf("Darkvision- Expected: %d, Result: %d", tt.expectedDarkvision, character.Darkvision)
+			}
+
+			if languages := character.GetLanguages(); !slices.Equal(tt.expectedLanguages, languages) {
+				t.Errorf("Languages- Expected: %v, Result: %v", tt.expectedLanguages, languages)
+			}
+		})
+	}
+}
+
+func TestCharacterSetRacialBonuses(t *testing.T) {
+	tests := []struct {
+		name            string
+		race            string
+		mode            string
+		abilities       []string
+		expectedBonuses []shared.AbilityScoreImprovementItem
+		expectErr       bool
+	}{
+		{
+			name:            "Flexible +2/+1",
+			race:            shared.RaceDwarf,
+			mode:            shared.RacialBonusFlexible,
+			abilities:       []string{"Dexterity", "wisdom"},
+			expectedBonuses: []shared.AbilityScoreImprovementItem{{Ability: shared.AbilityDexterity, Bonus: 2}, {Ability: shared.AbilityWisdom, Bonus: 1}},
+		},
+		{
+			name:            "Flexible three +1s",
+			race:            shared.RaceDwarf,
+			mode:            shared.RacialBonusFlexible,
+			abilities:       []string{shared.AbilityDexterity, shared.AbilityWisdom, shared.AbilityCharisma},
+			expectedBonuses: []shared.AbilityScoreImprovementItem{{Ability: shared.AbilityDexterity, Bonus: 1}, {Ability: shared.AbilityWisdom, Bonus: 1}, {Ability: shared.AbilityCharisma, Bonus: 1}},
+		},
+		{
+			name:      "Flexible needs two or three abilities",
+			race:      shared.RaceDwarf,
+			mode:      shared.RacialBonusFlexible,
+			abilities: []string{shared.AbilityDexterity},
+			expectErr: true,
+		},
+		{
+			name:            "Fixed without choices",
+			race:            shared.RaceDwarf,
+			mode:            shared.RacialBonusFixed,
+			expectedBonuses: []shared.AbilityScoreImprovementItem{},
+		},
+		{
+			name:      "Fixed choice on an ability the race improves",
+			race:      shared.RaceHalfElf,
+			mode:      shared.RacialBonusFixed,
+			abilities: []string{shared.AbilityCharisma, shared.AbilityWisdom},
+			expectErr: true,
+		},
+		{
+			name:      "Unknown mode",
+			race:      shared.RaceDwarf,
+			mode:      "random",
+			expectErr: true,
+		},
+		{
+			name:      "Unknown race",
+			race:      "Half Giant",
+			mode:      shared.RacialBonusFixed,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &Character{
+				Race: tt.race,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityDexterity},
+					{Name: shared.AbilityWisdom},
+					{Name: shared.AbilityCharisma},
+				},
+			}
+
+			err := character.SetRacialBonuses(tt.mode, tt.abilities)
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("Error- Expected: %v, Result: %v", tt.expectErr, err)
+			}
+
+			if tt.expectErr {
+				return
+			}
+
+			if tt.mode != character.RacialBonusMode {
+				t.Errorf("Mode- Expected: %s, Result: %s", tt.mode, character.RacialBonusMode)
+			}
+
+			if !slices.Equal(tt.expectedBonuses, character.RacialAbilityBonuses) {
+				t.Errorf("Bonuses- Expected: %v, Result: %v", tt.expectedBonuses, character.RacialAbilityBonuses)
+			}
+		})
+	}
+}
+
+func TestCharacterApplyBackground(t *testing.T) {
+	background := Background{
+		Name:               "acolyte",
+		SkillProficiencies: []string{shared.SkillInsight, shared.SkillReligion},
+		ToolProficiencies:  []string{"Calligrapher's Supplies"},
+		Languages:          []string{"Celestial"},
+		Equipment:          []shared.BackpackItem{{Name: "prayer book", Quantity: 1, Weight: 5}},
+		Purse:              shared.Purse{Gold: 15},
+		Feature:            GenericItem{Name: "Shelter of the Faithful"},
+	}
+
+	tests := []struct {
+		name               string
+		proficientSkills   []string
+		tools              []string
+		languages          []string
+		expectedDuplicates []string
+		expectedTools      []string
+		expectedLanguages  []string
+	}{
+		{
+			name:               "No duplicates",
+			languages:          []string{"Common"},
+			expectedDuplicates: []string{},
+			expectedTools:      []string{"calligrapher's supplies"},
+			expectedLanguages:  []string{"Common", "Celestial"},
+		},
+		{
+			name:               "Skill and tool duplicates are flagged",
+			proficientSkills:   []string{shared.SkillInsight},
+			tools:              []string{"calligrapher's supplies"},
+			languages:          []string{"celestial"},
+			expectedDuplicates: []string{shared.SkillInsight, "calligrapher's supplies"},
+			expectedTools:      []string{"calligrapher's supplies"},
+			expectedLanguages:  []string{"celestial"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &Character{
+				Background:        "Acolyte",
+				ToolProficiencies: tt.tools,
+				Languages:         tt.languages,
+				Skills: []shared.Skill{
+					{Name: "Insight", Proficient: slices.Contains(tt.proficientSkills, shared.SkillInsight)},
+					{Name: "Religion"},
+					{Name: "Stealth"},
+				},
+			}
+
+			duplicates := character.ApplyBackground(background)
+
+			if !slices.Equal(tt.expectedDuplicates, duplicates) {
+				t.Errorf("Duplicates- Expected: %v, Result: %v", tt.expectedDuplicates, duplicates)
+			}
+
+			if !slices.Equal(tt.expectedDuplicates, character.BackgroundDuplicates) {
+				t.Errorf("Character Duplicates- Expected: %v, Result: %v", tt.expectedDuplicates, character.BackgroundDuplicates)
+			}
+
+			for _, skill := range character.Skills {
+				expected := skill.Name != "Stealth"
+				if expected != skill.Proficient {
+					t.Errorf("%s Proficient- Expected: %v, Result: %v", skill.Name, expected, skill.Proficient)
+				}
+			}
+
+			if !slices.Equal(tt.expectedTools, character.ToolProficiencies) {
+				t.Errorf("Tools- Expected: %v, Result: %v", tt.expectedTools, character.ToolProficiencies)
+			}
+
+			if !slices.Equal(tt.expectedLanguages, character.Languages) {
+				t.Errorf("Languages- Expected: %v, Result: %v", tt.expectedLanguages, character.Languages)
+			}
+
+			if len(character.Backpack) != 1 || character.Backpack[0].Name != "prayer book" {
+				t.Errorf("Backpack- Expected: [prayer book], Result: %v", character.Backpack)
+			}
+
+			if character.Purse.Gold != 15 {
+				t.Errorf("Gold- Expected: %d, Result: %d", 15, character.Purse.Gold)
+			}
+
+			if character.BackgroundFeature.Name != background.Feature.Name {
+				t.Errorf("Feature- Expected: %s, Result: %s", background.Feature.Name, character.BackgroundFeature.Name)
+			}
+		})
+	}
+}
+
+func TestCharacterReplaceBackgroundDuplicate(t *testing.T) {
+	tests := []struct {
+		name               string
+		duplicate          string
+		replacement        string
+		expectedDuplicates []string
+		expectedTools      []string
+		expectedStealth    bool
+		expectErr          bool
+	}{
+		{
+			name:               "Replace with a skill",
+			duplicate:          "Insight",
+			replacement:        "stealth",
+			expectedDuplicates: []string{"thieves' tools"},
+			expectedTools:      []string{"thieves' tools"},
+			expectedStealth:    true,
+		},
+		{
+			name:               "Replace with a tool",
+			duplicate:          "thieves' tools",
+			replacement:        "Lute",
+			expectedDuplicates: []string{"insight"},
+			expectedTools:      []string{"thieves' tools", "lute"},
+		},
+		{
+			name:        "Not a duplicate",
+			duplicate:   "religion",
+			replacement: "stealth",
+			expectErr:   true,
+		},
+		{
+			name:        "Already proficient skill",
+			duplicate:   "insight",
+			replacement: "insight",
+			expectErr:   true,
+		},
+		{
+			name:        "Already proficient tool",
+			duplicate:   "insight",
+			replacement: "Thieves' Tools",
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &Character{
+				BackgroundDuplicates: []string{"insight", "thieves' tools"},
+				ToolProficiencies:    []string{"thieves' tools"},
+				Skills: []shared.Skill{
+					{Name: "Insight", Proficient: true},
+					{Name: "Stealth"},
+				},
+			}
+
+			err := character.ReplaceBackgroundDuplicate(tt.duplicate, tt.replacement)
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("Error- Expected: %v, Result: %v", tt.expectErr, err)
+			}
+
+			if tt.expectErr {
+				return
+			}
+
+			if !slices.Equal(tt.expectedDuplicates, character.BackgroundDuplicates) {
+				t.Errorf("Duplicates- Expected: %v, Result: %v", tt.expectedDuplicates, character.BackgroundDuplicates)
+			}
+
+			if !slices.Equal(tt.expectedTools, character.ToolProficiencies) {
+				t.Errorf("Tools- Expected: %v, Result: %v", tt.expectedTools, character.ToolProficiencies)
+			}
+
+			if tt.expectedStealth != character.Skills[1].Proficient {
+				t.Errorf("Stealth Proficient- Expected: %v, Result: %v", tt.expectedStealth, character.Skills[1].Proficient)
+			}
+		})
+	}
+}
+
+func TestCharacterCalculateFeats(t *testing.T) {
+	tests := []struct {
+		name                  string
+		feats                 []Feat
+		asi                   []shared.AbilityScoreImprovementItem
+		expectedHPMax         int
+		expectedInitiative    int
+		expectedPerception    int
+		expectedInvestigation int
+		expectedSpeed         int
+		expectedConstitution  int
+		expectedConSave       int
+	}{
+		{
+			name:                  "No feats",
+			expectedHPMax:         30,
+			expectedInitiative:    2,
+			expectedPerception:    10,
+			expectedInvestigation: 10,
+			expectedSpeed:         30,
+			expectedConstitution:  14,
+			expectedConSave:       2,
+		},
+		{
+			name:                  "Homebrew feat has no effect",
+			feats:                 []Feat{{Name: "Pie Eater", Desc: "Eats pie"}},
+			expectedHPMax:         30,
+			expectedInitiative:    2,
+			expectedPerception:    10,
+			expectedInvestigation: 10,
+			expectedSpeed:         30,
+			expectedConstitution:  14,
+			expectedConSave:       2,
+		},
+		{
+			name:                  "Tough, Alert and Mobile",
+			feats:                 []Feat{{Name: "Tough"}, {Name: "alert"}},
+			asi:                   []shared.AbilityScoreImprovementItem{{Feat: shared.FeatMobile}},
+			expectedHPMax:         38,
+			expectedInitiative:    7,
+			expectedPerception:    10,
+			expectedInvestigation: 10,
+			expectedSpeed:         40,
+			expectedConstitution:  14,
+			expectedConSave:       2,
+		},
+		{
+			name:                  "Resilient from an ability score improvement",
+			asi:                   []shared.AbilityScoreImprovementItem{{Feat: shared.FeatResilient, Ability: shared.AbilityConstitution, Bonus: 1}},
+			expectedHPMax:         30,
+			expectedInitiative:    2,
+			expectedPerception:    10,
+			expectedInvestigation: 10,
+			expectedSpeed:         30,
+			expectedConstitution:  15,
+			expectedConSave:       4,
+		},
+		{
+			name:                  "Observant added directly",
+			feats:                 []Feat{{Name: "Observant", Ability: shared.AbilityWisdom}},
+			expectedHPMax:         30,
+			expectedInitiative:    2,
+			expectedPerception:    15,
+			expectedInvestigation: 15,
+			expectedSpeed:         30,
+			expectedConstitution:  14,
+			expectedConSave:       2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &Character{
+				HPMax:                   30,
+				Speed:                   30,
+				Feats:                   tt.feats,
+				AbilityScoreImprovement: tt.asi,
+				Classes:                 []Class{&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 4}}},
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityDexterity, Base: 14},
+					{Name: shared.AbilityConstitution, Base: 14},
+					{Name: shared.AbilityIntelligence, Base: 10},
+					{Name: shared.AbilityWisdom, Base: 10},
+				},
+			}
+
+			character.CalculateCharacterStats()
+
+			if tt.expectedHPMax != character.HPMaxAdjusted {
+				t.Errorf("HPMaxAdjusted- Expected: %d, Result: %d", tt.expectedHPMax, character.HPMaxAdjusted)
+			}
+
+			if tt.expectedInitiative != character.Initiative {
+				t.Errorf("Initiative- Expected: %d, Result: %d", tt.expectedInitiative, character.Initiative)
+			}
+
+			if tt.expectedPerception != character.PassivePerception {
+				t.Errorf("Passive Perception- Expected: %d, Result: %d", tt.expectedPerception, character.PassivePerception)
+			}
+
+			if tt.expectedInvestigation != character.PassiveInvestigation {
+				t.Errorf("Passive Investigation- Expected: %d, Result: %d", tt.expectedInvestigation, character.PassiveInvestigation)
+			}
+
+			if tt.expectedSpeed != character.SpeedAdjusted {
+				t.Errorf("SpeedAdjusted- Expected: %d, Result: %d", tt.expectedSpeed, character.SpeedAdjusted)
+			}
+
+			con := character.Abilities[1]
+			if tt.expectedConstitution != con.Adjusted {
+				t.Errorf("Constitution- Expected: %d, Result: %d", tt.expectedConstitution, con.Adjusted)
+			}
+
+			conSave := character.GetSavingThrowMod(shared.AbilityConstitution)
+			if tt.expectedConSave != conSave {
+				t.Errorf("Constitution Save- Expected: %d, Result: %d", tt.expectedConSave, conSave)
+			}
+
+			// Feat proficiencies are derived, they should never be saved on the ability
+			if con.SavingThrowsProficient {
+				t.Errorf("Constitution Save Proficient- Expected: false, Result: true")
+			}
+		})
+	}
+}
+
+func TestCharacterChooseFeat(t *testing.T) {
+	tests := []struct {
+		name        string
+		feat        string
+		ability     string
+		expectedASI []shared.AbilityScoreImprovementItem
+		expectErr   bool
+	}{
+		{
+			name:        "Feat without an ability",
+			feat:        "Alert",
+			expectedASI: []shared.AbilityScoreImprovementItem{{Ability: shared.AbilityStrength, Bonus: 2}, {Feat: shared.FeatAlert}},
+		},
+		{
+			name:        "Feat with an ability",
+			feat:        "resilient",
+			ability:     "Wisdom",
+			expectedASI: []shared.AbilityScoreImprovementItem{{Ability: shared.AbilityStrength, Bonus: 2}, {Feat: shared.FeatResilient, Ability: shared.AbilityWisdom, Bonus: 1}},
+		},
+		{
+			name:        "Homebrew feat ignores the ability",
+			feat:        "Pie Eater",
+			ability:     shared.AbilityWisdom,
+			expectedASI: []shared.AbilityScoreImprovementItem{{Ability: shared.AbilityStrength, Bonus: 2}, {Feat: "pie eater"}},
+		},
+		{
+			name:      "Ability not allowed for feat",
+			feat:      shared.FeatObservant,
+			ability:   shared.AbilityStrength,
+			expectErr: true,
+		},
+		{
+			name:      "Missing ability",
+			feat:      shared.FeatResilient,
+			expectErr: true,
+		},
+		{
+			name:      "Feat already taken",
+			feat:      shared.FeatTough,
+			expectErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.character.RemoveItemFromPack(tt.itemName, tt.quantity)
+			character := &Character{
+				Feats:                   []Feat{{Name: "Tough"}},
+				AbilityScoreImprovement: []shared.AbilityScoreImprovementItem{{Ability: shared.AbilityStrength, Bonus: 2}},
+			}
 
-			if len(tt.expected) != len(tt.character.Backpack) {
-				t.Errorf("Item Count- Expected: %d, Result: %d", len(tt.expected), len(tt.character.Backpack))
+			err := character.ChooseFeat(tt.feat, tt.ability)
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("Error- Expected: %v, Result: %v", tt.expectErr, err)
 			}
 
-			for i, e := range tt.expected {
-				result := tt.character.Backpack[i]
+			if tt.expectErr {
+				return
+			}
 
-				if e.Quantity != result.Quantity {
-					t.Errorf("Item Quantity %s- Expected: %d, Result: %d", e.Name, e.Quantity, result.Quantity)
-				}
+			if !slices.Equal(tt.expectedASI, character.AbilityScoreImprovement) {
+				t.Errorf("Ability Score Improvement- Expected: %v, Result: %v", tt.expectedASI, character.AbilityScoreImprovement)
 			}
 		})
 	}
 }
 
-func TestCharacterAddItemToBackpack(t *testing.T) {
+func TestCharacterCalculateMagicItems(t *testing.T) {
 	tests := []struct {
-		name      string
-		character *Character
-		itemName  string
-		quantity  int
-		expected  []shared.BackpackItem
+		name                string
+		wornEquipment       shared.WornEquipment
+		expectedStrength    int
+		expectedDexSave     int
+		expectedSpeed       int
+		expectedResistances []string
 	}{
 		{
-			name:     "Add 1 New Item",
-			itemName: "soap",
-			quantity: 5,
-			character: &Character{
-				Backpack: []shared.BackpackItem{
-					{Name: "gold", Quantity: 5},
-				},
-			},
-			expected: []shared.BackpackItem{
-				{Name: "gold", Quantity: 5},
-				{Name: "soap", Quantity: 5},
-			},
+			name:                "No magic items",
+			wornEquipment:       shared.WornEquipment{Cloak: shared.MagicItem{Name: "traveler's cloak"}},
+			expectedStrength:    16,
+			expectedDexSave:     2,
+			expectedSpeed:       30,
+			expectedResistances: []string{shared.DamagePoison},
 		},
 		{
-			name:     "Add 1 Existing Item",
-			itemName: "soap",
-			quantity: 5,
-			character: &Character{
-				Backpack: []shared.BackpackItem{
-					{Name: "gold", Quantity: 5},
-					{Name: "soap", Quantity: 5},
+			name:                "Magic items not attuned",
+			wornEquipment:       shared.WornEquipment{Belt: shared.MagicItem{Name: shared.MagicItemBeltOfHillGiantStrength}, Ring: shared.MagicItem{Name: shared.MagicItemRingOfProtection}},
+			expectedStrength:    16,
+			expectedDexSave:     2,
+			expectedSpeed:       30,
+			expectedResistances: []string{shared.DamagePoison},
+		},
+		{
+			name:                "Homebrew item without attunement",
+			wornEquipment:       shared.WornEquipment{Boots: shared.MagicItem{Name: "boots of hurrying", Speed: 5}},
+			expectedStrength:    16,
+			expectedDexSave:     2,
+			expectedSpeed:       35,
+			expectedResistances: []string{shared.DamagePoison},
+		},
+		{
+			name:                "Belt sets strength",
+			wornEquipment:       shared.WornEquipment{Belt: shared.MagicItem{Name: shared.MagicItemBeltOfHillGiantStrength, Attuned: true}},
+			expectedStrength:    21,
+			expectedDexSave:     2,
+			expectedSpeed:       30,
+			expectedResistances: []string{shared.DamagePoison},
+		},
+		{
+			name:                "Gauntlets and ring of protection",
+			wornEquipment:       shared.WornEquipment{HandsArms: shared.MagicItem{Name: "Gauntlets of Ogre Power", Attuned: true}, Ring: shared.MagicItem{Name: shared.MagicItemRingOfProtection, Attuned: true}},
+			expectedStrength:    19,
+			expectedDexSave:     3,
+			expectedSpeed:       30,
+			expectedResistances: []string{shared.DamagePoison},
+		},
+		{
+			name: "Homebrew item overrides a known item",
+			wornEquipment: shared.WornEquipment{
+				Boots: shared.MagicItem{
+					Name:          shared.MagicItemBootsOfTheWinterlands,
+					Speed:         10,
+					AbilityScores: map[string]int{shared.AbilityStrength: 12},
+					Resistances:   []string{shared.DamageFire, shared.DamagePoison},
+					Attuned:       true,
 				},
+				Ring2: shared.MagicItem{Name: shared.MagicItemRingOfColdResistance, Attuned: true},
 			},
-			expected: []shared.BackpackItem{
-				{Name: "gold", Quantity: 5},
-				{Name: "soap", Quantity: 10},
-			},
+			expectedStrength:    16,
+			expectedDexSave:     2,
+			expectedSpeed:       40,
+			expectedResistances: []string{shared.DamagePoison, shared.DamageCold, shared.DamageFire},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.character.AddItemToPack(tt.itemName, tt.quantity)
+			character := &Character{
+				Speed:         30,
+				Resistances:   []string{shared.DamagePoison},
+				WornEquipment: tt.wornEquipment,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityStrength, Base: 16},
+					{Name: shared.AbilityDexterity, Base: 14},
+				},
+			}
 
-			if len(tt.expected) != len(tt.character.Backpack) {
-				t.Errorf("Item Count- Expected: %d, Result: %d", len(tt.expected), len(tt.character.Backpack))
+			character.CalculateCharacterStats()
+
+			if tt.expectedStrength != character.Abilities[0].Adjusted {
+				t.Errorf("Strength- Expected: %d, Result: %d", tt.expectedStrength, character.Abilities[0].Adjusted)
 			}
 
-			for i, e := range tt.expected {
-				result := tt.character.Backpack[i]
+			if result := character.GetSavingThrowMod(shared.AbilityDexterity); tt.expectedDexSave != result {
+				t.Errorf("Dexterity Save- Expected: %d, Result: %d", tt.expectedDexSave, result)
+			}
 
-				if e != result {
-					t.Errorf("Item Quantity %s- Expected: %d, Result: %d", e.Name, e.Quantity, result.Quantity)
-				}
+			if tt.expectedSpeed != character.SpeedAdjusted {
+				t.Errorf("SpeedAdjusted- Expected: %d, Result: %d", tt.expectedSpeed, character.SpeedAdjusted)
+			}
+
+			if result := character.GetResistances(); !slices.Equal(tt.expectedResistances, result) {
+				t.Errorf("Resistances- Expected: %v, Result: %v", tt.expectedResistances, result)
 			}
 		})
 	}
 }
 
-func TestCharacterEquip(t *testing.T) {
+func TestCharacterUnmarshalWornEquipment(t *testing.T) {
 	tests := []struct {
 		name       string
-		character  *Character
-		itemName   string
-		isPrimary  bool
-		ePrimary   string
-		eSecondary string
+		data       string
+		expectedAC int
 	}{
 		{
-			name: "No items equipped, equip primary weapon",
-			character: &Character{
-				PrimaryEquipped:   "",
-				SecondaryEquipped: "",
-				Weapons: []shared.Weapon{
-					{Name: "Rapier"},
-					{Name: "Dagger"},
-					{Name: "Club"},
-				},
-				WornEquipment: shared.WornEquipment{
-					Shield: "Some Shield",
-				},
-			},
-			itemName:   "Rapier",
-			isPrimary:  true,
-			ePrimary:   "Rapier",
-			eSecondary: "",
+			name:       "Worn equipment saved as names",
+			data:       `{"worn-equipment": {"ring": "ring of protection", "cloak": "traveler's cloak"}}`,
+			expectedAC: 10,
 		},
 		{
-			name: "No items equipped, equip primary shield",
-			character: &Character{
-				PrimaryEquipped:   "",
-				SecondaryEquipped: "",
-				Weapons: []shared.Weapon{
-					{Name: "Rapier"},
-					{Name: "Dagger"},
-					{Name: "Club"},
-				},
-				WornEquipment: shared.WornEquipment{
-					Shield: "Some Shield",
-				},
-			},
-			itemName:   "Some Shield",
-			isPrimary:  true,
-			ePrimary:   "Some Shield",
-			eSecondary: "",
+			name:       "Attuned known item",
+			data:       `{"worn-equipment": {"ring": {"name": "ring of protection", "attuned": true}}}`,
+			expectedAC: 11,
 		},
 		{
-			name: "No items equipped, equip secondary weapon",
+			name:       "Worn equipment saved as items",
+			data:       `{"worn-equipment": {"ring": {"name": "ring of warding", "ac-bonus": 2}}}`,
+			expectedAC: 12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var character Character
+			if err := json.Unmarshal([]byte(tt.data), &character); err != nil {
+				t.Fatalf("Unmarshal- Unexpected error: %v", err)
+			}
+
+			character.calculateAC()
+
+			if tt.expectedAC != character.AC {
+				t.Errorf("AC- Expected: %d, Result: %d", tt.expectedAC, character.AC)
+			}
+		})
+	}
+}
+
+func TestCharacterAttune(t *testing.T) {
+	tests := []struct {
+		name            string
+		item            string
+		character       *Character
+		expectedAttuned []string
+		expectErr       bool
+	}{
+		{
+			name: "Worn item",
+			item: "Ring of Protection",
 			character: &Character{
-				PrimaryEquipped:   "",
-				SecondaryEquipped: "",
-				Weapons: []shared.Weapon{
-					{Name: "Rapier"},
-					{Name: "dagger"}, // testing casing
-					{Name: "Club"},
-				},
-				WornEquipment: shared.WornEquipment{
-					Shield: "Some Shield",
-				},
+				WornEquipment: shared.WornEquipment{Ring: shared.MagicItem{Name: shared.MagicItemRingOfProtection}},
 			},
-			itemName:   "Dagger",
-			isPrimary:  false,
-			ePrimary:   "",
-			eSecondary: "Dagger",
+			expectedAttuned: []string{shared.MagicItemRingOfProtection},
 		},
 		{
-			name: "Primary equipped, equip secondary weapon",
+			name: "Backpack item",
+			item: shared.MagicItemAmuletOfHealth,
 			character: &Character{
-				PrimaryEquipped:   "Rapier",
-				SecondaryEquipped: "",
-				Weapons: []shared.Weapon{
-					{Name: "Rapier"},
-					{Name: "Dagger"},
-					{Name: "Club"},
-				},
-				WornEquipment: shared.WornEquipment{
-					Shield: "Some Shield",
-				},
+				WornEquipment: shared.WornEquipment{Ring: shared.MagicItem{Name: shared.MagicItemRingOfProtection, Attuned: true}},
+				Backpack:      []shared.BackpackItem{{Name: shared.MagicItemAmuletOfHealth, Quantity: 1}},
 			},
-			itemName:   "Dagger",
-			isPrimary:  false,
-			ePrimary:   "Rapier",
-			eSecondary: "Dagger",
+			expectedAttuned: []string{shared.MagicItemRingOfProtection, shared.MagicItemAmuletOfHealth},
 		},
 		{
-			name: "Both equipped, equip secondary weapon as primary",
+			name: "Already attuned to three items",
+			item: shared.MagicItemCloakOfProtection,
 			character: &Character{
-				PrimaryEquipped:   "Rapier",
-				SecondaryEquipped: "Club",
-				Weapons: []shared.Weapon{
-					{Name: "Rapier"},
-					{Name: "Dagger"},
-					{Name: "Club"},
-				},
 				WornEquipment: shared.WornEquipment{
-					Shield: "Some Shield",
+					Ring:  shared.MagicItem{Name: shared.MagicItemRingOfProtection, Attuned: true},
+					Ring2: shared.MagicItem{Name: shared.MagicItemRingOfFireResistance, Attuned: true},
+					Belt:  shared.MagicItem{Name: shared.MagicItemBeltOfHillGiantStrength, Attuned: true},
+					Cloak: shared.MagicItem{Name: shared.MagicItemCloakOfProtection},
 				},
 			},
-			itemName:   "Club",
-			isPrimary:  true,
-			ePrimary:   "Club",
-			eSecondary: "",
+			expectErr: true,
 		},
 		{
-			name: "Equipping already equipped primary as secondary",
+			name: "Already attuned to the item",
+			item: shared.MagicItemRingOfProtection,
 			character: &Character{
-				PrimaryEquipped:   "Rapier",
-				SecondaryEquipped: "",
-				Weapons: []shared.Weapon{
-					{Name: "Rapier"},
-					{Name: "Dagger"},
-					{Name: "Club"},
-				},
-				WornEquipment: shared.WornEquipment{
-					Shield: "Some Shield",
-				},
+				WornEquipment: shared.WornEquipment{Ring: shared.MagicItem{Name: shared.MagicItemRingOfProtection, Attuned: true}},
 			},
-			itemName:   "Rapier",
-			isPrimary:  false,
-			ePrimary:   "",
-			eSecondary: "Rapier",
+			expectErr: true,
 		},
 		{
-			name: "Equipping weapon we have two of as primary and secondary",
+			name: "Class can't attune",
+			item: shared.MagicItemRobeOfTheArchmagi,
 			character: &Character{
-				PrimaryEquipped:   "Rapier",
-				SecondaryEquipped: "Club",
-				Weapons: []shared.Weapon{
-					{Name: "Rapier"},
-					{Name: "Club"},
-					{Name: "Club"},
-				},
-				WornEquipment: shared.WornEquipment{
-					Shield: "Some Shield",
-				},
+				Classes:       []Class{&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 3}}},
+				WornEquipment: shared.WornEquipment{Cloak: shared.MagicItem{Name: shared.MagicItemRobeOfTheArchmagi}},
 			},
-			itemName:   "Club",
-			isPrimary:  true,
-			ePrimary:   "Club",
-			eSecondary: "Club",
+			expectErr: true,
 		},
 		{
-			name: "Equipment not found",
+			name: "Class can attune",
+			item: shared.MagicItemRobeOfTheArchmagi,
 			character: &Character{
-				PrimaryEquipped:   "",
-				SecondaryEquipped: "",
-				Weapons: []shared.Weapon{
-					{Name: "Rapier"},
-					{Name: "Club"},
-					{Name: "Dagger"},
-				},
-				WornEquipment: shared.WornEquipment{
-					Shield: "Some Shield",
+				Classes: []Class{
+					&testClass{BaseClass{ClassType: shared.ClassFighter, Level: 3}},
+					&testClass{BaseClass{ClassType: shared.ClassWizard, Level: 1}},
 				},
+				WornEquipment: shared.WornEquipment{Cloak: shared.MagicItem{Name: shared.MagicItemRobeOfTheArchmagi}},
 			},
-			itemName:   "Longbow",
-			isPrimary:  true,
-			ePrimary:   "",
-			eSecondary: "",
+			expectedAttuned: []string{shared.MagicItemRobeOfTheArchmagi},
+		},
+		{
+			name:      "Item not found",
+			item:      shared.MagicItemRingOfProtection,
+			character: &Character{Backpack: []shared.BackpackItem{{Name: shared.MagicItemRingOfProtection, Quantity: 0}}},
+			expectErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.character.Equip(tt.isPrimary, tt.itemName)
+			err := tt.character.Attune(tt.item)
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("Error- Expected: %v, Result: %v", tt.expectErr, err)
+			}
 
-			rPrimary := tt.character.PrimaryEquipped
-			rSecondary := tt.character.SecondaryEquipped
+			if tt.expectErr {
+				return
+			}
 
-			if tt.ePrimary != rPrimary {
-				t.Errorf("Primary- Expected: %s, Result: %s", tt.ePrimary, rPrimary)
+			if result := tt.character.GetAttunedItems(); !slices.Equal(tt.expectedAttuned, result) {
+				t.Errorf("Attuned Items- Expected: %v, Result: %v", tt.expectedAttuned, result)
 			}
-			if tt.eSecondary != rSecondary {
-				t.Errorf("Secondary- Expected: %s, Result: %s", tt.eSecondary, rSecondary)
+		})
+	}
+}
+
+func TestCharacterUnattune(t *testing.T) {
+	tests := []struct {
+		name            string
+		item            string
+		expectedAttuned []string
+		expectErr       bool
+	}{
+		{
+			name:            "Worn item",
+			item:            "ring of protection",
+			expectedAttuned: []string{shared.MagicItemAmuletOfHealth},
+		},
+		{
+			name:            "Backpack item",
+			item:            shared.MagicItemAmuletOfHealth,
+			expectedAttuned: []string{shared.MagicItemRingOfProtection},
+		},
+		{
+			name:      "Item not attuned",
+			item:      shared.MagicItemBeltOfHillGiantStrength,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &Character{
+				WornEquipment: shared.WornEquipment{
+					Ring: shared.MagicItem{Name: shared.MagicItemRingOfProtection, Attuned: true},
+					Belt: shared.MagicItem{Name: shared.MagicItemBeltOfHillGiantStrength},
+				},
+				Backpack: []shared.BackpackItem{{Name: shared.MagicItemAmuletOfHealth, Quantity: 1, Attuned: true}},
+			}
+
+			err := character.Unattune(tt.item)
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("Error- Expected: %v, Result: %v", tt.expectErr, err)
+			}
+
+			if tt.expectErr {
+				return
+			}
+
+			if result := character.GetAttunedItems(); !slices.Equal(tt.expectedAttuned, result) {
+				t.Errorf("Attuned Items- Expected: %v, Result: %v", tt.expectedAttuned, result)
 			}
 		})
 	}
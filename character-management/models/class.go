@@ -2,6 +2,10 @@ package models
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/onioncall/dndgo/character-management/shared"
 )
 
 type BaseClass struct {
@@ -10,6 +14,7 @@ type BaseClass struct {
 	ClassType     string         `json:"class-type" clover:"class-type"`
 	Level         int            `json:"level" clover:"level"`
 	OtherFeatures []ClassFeature `json:"other-features" clover:"other-features"`
+	HitDiceUsed   int            `json:"hit-dice-used" clover:"hit-dice-used"`
 }
 
 type Class interface {
@@ -24,6 +29,13 @@ type Class interface {
 	GetCharacterId() string
 	SetCharacterId(id string)
 	SetClassType(name string)
+	GetHitDie() int
+	GetHitDiceAvailable() int
+	UseHitDice(quantity int) error
+	RecoverHitDice(quantity int)
+	GetClassFeaturesAtLevel(level int) []ClassFeature
+	IsAbilityScoreImprovementLevel() bool
+	NeedsSubClass() bool
 }
 
 type PostCalculator interface {
@@ -40,6 +52,11 @@ type TokenClass interface {
 	RecoverClassTokens(string, int)
 }
 
+// Classes with resources that are recovered on a short rest
+type ShortRestClass interface {
+	RecoverShortRestTokens()
+}
+
 type ExpertiseClass interface {
 	AddExpertiseSkill(skill string) error
 }
@@ -48,11 +65,44 @@ type PreparedSpellClass interface {
 	AddPreparedSpell(spell string) error
 	RemovePreparedSpell(spell string) error
 	GetPreparedSpells() []string
+	GetPreparedSpellsMax() int
+}
+
+// Classes that copy spells into a book to prepare from, like a wizard
+type SpellbookClass interface {
+	AddSpellbookSpell(spell string) error
+	RemoveSpellbookSpell(spell string) error
+	GetSpellbook() []string
 }
 
+// Classes that restore expended spell slots on a short rest, like a wizard's Arcane Recovery
+type SlotRecoveryClass interface {
+	GetSlotRecoveryLevels() int
+	UseSlotRecovery() error
+}
+
+// Classes that keep their own spell slots apart from the character's, like a warlock's Pact Magic
 type SpellCasterClass interface {
 	UseSpellSlot(level int) error
 	RecoverSpellSlots(level int, quantity int)
+	GetSpellSlots() []shared.SpellSlot
+}
+
+// Classes with sorcery points to trade for spell slots and spend on metamagic
+type SorceryPointClass interface {
+	GetSorceryPoints() int
+	SpendSorceryPoints(points int) error
+	GainSorceryPoints(points int) error
+	GetMetamagicCost(metamagic string, spellLevel int) (int, error)
+}
+
+// Classes that learn a fixed number of spells and cantrips per level instead of preparing them.
+// One known spell can be swapped for another each time the class levels up
+type KnownSpellClass interface {
+	GetSpellsKnown() int
+	GetCantripsKnown() int
+	GetSpellSwap() bool
+	SetSpellSwap(available bool)
 }
 
 type OathSpellClass interface {
@@ -64,6 +114,12 @@ type FightingStyleClass interface {
 	ModifyFightingStyle(fightingStyle string) error
 }
 
+type InvocationClass interface {
+	AddInvocation(invocation string) error
+	RemoveInvocation(invocation string) error
+	SetPactBoon(pactBoon string) error
+}
+
 type FavoredEnemyClass interface {
 	AddFavoredEnemy(favoredEnemy string) error
 	RemoveFavoredEnemy(favoredEnemy string) error
@@ -107,6 +163,28 @@ func (c *BaseClass) SetSubClass(subClass string) {
 	c.SubClass = subClass
 }
 
+func (c *BaseClass) GetHitDie() int {
+	return shared.ClassHitDie[strings.ToLower(c.ClassType)]
+}
+
+// A class has one hit die per class level, hit dice are spent on short rests and recovered on long rests
+func (c *BaseClass) GetHitDiceAvailable() int {
+	return max(c.Level-c.HitDiceUsed, 0)
+}
+
+func (c *BaseClass) UseHitDice(quantity int) error {
+	if quantity > c.GetHitDiceAvailable() {
+		return fmt.Errorf("Only %d hit dice available for class '%s'", c.GetHitDiceAvailable(), c.ClassType)
+	}
+
+	c.HitDiceUsed += quantity
+	return nil
+}
+
+func (c *BaseClass) RecoverHitDice(quantity int) {
+	c.HitDiceUsed = max(c.HitDiceUsed-quantity, 0)
+}
+
 func (c *BaseClass) GetClassFeatures() string {
 	var s string
 	if len(c.OtherFeatures) > 0 {
@@ -124,3 +202,29 @@ func (c *BaseClass) GetClassFeatures() string {
 
 	return s
 }
+
+// Features gained at exactly the given class level
+func (c *BaseClass) GetClassFeaturesAtLevel(level int) []ClassFeature {
+	features := []ClassFeature{}
+	for _, feature := range c.OtherFeatures {
+		if feature.Level == level {
+			features = append(features, feature)
+		}
+	}
+
+	return features
+}
+
+func (c *BaseClass) IsAbilityScoreImprovementLevel() bool {
+	classType := strings.ToLower(c.ClassType)
+
+	return slices.Contains(shared.AbilityScoreImprovementLevels, c.Level) ||
+		slices.Contains(shared.ClassExtraAbilityScoreImprovementLevels[classType], c.Level)
+}
+
+// True once the class has reached its subclass level without a subclass being chosen
+func (c *BaseClass) NeedsSubClass() bool {
+	subClassLevel, ok := shared.ClassSubClassLevel[strings.ToLower(c.ClassType)]
+
+	return ok && c.SubClass == "" && c.Level >= subClassLevel
+}
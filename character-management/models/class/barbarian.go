@@ -51,6 +51,8 @@ func (b *Barbarian) executeRage(c *models.Character) {
 		return
 	}
 
+	b.ClassToken.RecoveryType = shared.TokenRecoveryLongRest
+
 	switch {
 	case c.Level < 3:
 		b.ClassToken.Maximum = 2
@@ -180,6 +182,10 @@ func (b *Barbarian) RecoverClassTokens(tokenName string, quantity int) {
 	}
 }
 
+func (b *Barbarian) RecoverShortRestTokens() {
+	recoverShortRestToken(&b.ClassToken)
+}
+
 func (b *Barbarian) GetTokens() []string {
 	return []string{
 		"rage",
@@ -14,10 +14,17 @@ import (
 
 type Bard struct {
 	models.BaseClass
-	ExpertiseSkills []string          `json:"expertise" clover:"expertise"`
-	ClassToken      shared.NamedToken `json:"class-token" clover:"class-token"`
+	ExpertiseSkills    []string          `json:"expertise" clover:"expertise"`
+	ClassToken         shared.NamedToken `json:"class-token" clover:"class-token"`
+	SpellSwap          bool              `json:"spell-swap" clover:"spell-swap"`
+	SpellsKnownCount   int               `json:"-" clover:"-"`
+	CantripsKnownCount int               `json:"-" clover:"-"`
 }
 
+// Spells and cantrips known by bard level, indexed by bard level - 1
+var bardSpellsKnown = [shared.MaxLevel]int{4, 5, 6, 7, 8, 9, 10, 11, 12, 14, 15, 15, 16, 18, 19, 19, 20, 22, 22, 22}
+var bardCantripsKnown = [shared.MaxLevel]int{2, 2, 2, 3, 3, 3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4}
+
 const (
 	bardicInspirationToken  string = "bardic-inspiration"
 	bardSpellCastingAbility string = shared.AbilityCharisma
@@ -37,17 +44,19 @@ func (b *Bard) ExecutePostCalculateMethods(c *models.Character) {
 	b.executeExpertise(c)
 	b.executeSpellCastingAbility(c)
 	b.executeBardicInspiration(c)
+	b.executeKnownSpells(c)
 }
 
 func (b *Bard) CalculateHitDice() string {
 	return fmt.Sprintf("%dd8", b.Level)
 }
 
-func (b *Bard) executeSpellCastingAbility(c *models.Character) {
-	chrMod := c.GetMod(bardSpellCastingAbility)
+func (b *Bard) executeKnownSpells(c *models.Character) {
+	b.SpellsKnownCount, b.CantripsKnownCount = executeKnownSpellsShared(c, b.ClassType, b.GetSpellsKnown(), b.GetCantripsKnown())
+}
 
-	executeSpellSaveDC(c, chrMod)
-	executeSpellAttackMod(c, chrMod)
+func (b *Bard) executeSpellCastingAbility(c *models.Character) {
+	c.SetSpellcasting(b.ClassType, bardSpellCastingAbility)
 }
 
 func (b *Bard) executeBardicInspiration(c *models.Character) {
@@ -57,6 +66,12 @@ func (b *Bard) executeBardicInspiration(c *models.Character) {
 	}
 
 	b.ClassToken.Maximum = c.GetMod(shared.AbilityCharisma)
+
+	// Font of Inspiration, starting at level 5 bardic inspiration is recovered on a short rest
+	b.ClassToken.RecoveryType = shared.TokenRecoveryLongRest
+	if b.Level >= 5 {
+		b.ClassToken.RecoveryType = shared.TokenRecoveryShortRest
+	}
 }
 
 // At b.Level 3, bards can pick two skills they are proficient in, and double the proficiency.
@@ -94,6 +109,7 @@ func (b *Bard) executeJackOfAllTrades(c *models.Character) {
 func (b *Bard) ClassDetails() string {
 	var s string
 	s += fmt.Sprintf("Level: %d\n", b.Level)
+	s += formatKnownSpells(b.SpellsKnownCount, b.GetSpellsKnown(), b.CantripsKnownCount, b.GetCantripsKnown(), b.SpellSwap)
 	s += formatTokens(b.ClassToken, bardicInspirationToken, b.Level) + "\n"
 
 	if len(b.ExpertiseSkills) > 0 && b.Level >= 3 {
@@ -141,6 +157,10 @@ func (b *Bard) RecoverClassTokens(tokenName string, quantity int) {
 	}
 }
 
+func (b *Bard) RecoverShortRestTokens() {
+	recoverShortRestToken(&b.ClassToken)
+}
+
 func (b *Bard) GetTokens() []string {
 	return []string{
 		bardicInspirationToken,
@@ -160,3 +180,19 @@ func (b *Bard) AddExpertiseSkill(skill string) error {
 
 	return nil
 }
+
+func (b *Bard) GetSpellsKnown() int {
+	return getKnownByLevel(bardSpellsKnown, b.Level)
+}
+
+func (b *Bard) GetCantripsKnown() int {
+	return getKnownByLevel(bardCantripsKnown, b.Level)
+}
+
+func (b *Bard) GetSpellSwap() bool {
+	return b.SpellSwap
+}
+
+func (b *Bard) SetSpellSwap(available bool) {
+	b.SpellSwap = available
+}
@@ -250,3 +250,117 @@ func TestBardRecoverClassSlots(t *testing.T) {
 		})
 	}
 }
+
+func TestBardRecoverShortRestTokens(t *testing.T) {
+	tests := []struct {
+		name      string
+		character *models.Character
+		bard      *Bard
+		expected  shared.NamedToken
+	}{
+		{
+			name: "Below level 5, not recovered on short rest",
+			character: &models.Character{
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityCharisma, AbilityModifier: 3},
+				},
+			},
+			bard: &Bard{
+				BaseClass: models.BaseClass{Level: 4},
+				ClassToken: shared.NamedToken{
+					Name:      "bardic-inspiration",
+					Available: 1,
+				},
+			},
+			expected: shared.NamedToken{
+				Available:    1,
+				RecoveryType: shared.TokenRecoveryLongRest,
+			},
+		},
+		{
+			name: "Font of Inspiration, recovered on short rest",
+			character: &models.Character{
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityCharisma, AbilityModifier: 3},
+				},
+			},
+			bard: &Bard{
+				BaseClass: models.BaseClass{Level: 5},
+				ClassToken: shared.NamedToken{
+					Name:      "bardic-inspiration",
+					Available: 1,
+				},
+			},
+			expected: shared.NamedToken{
+				Available:    3,
+				RecoveryType: shared.TokenRecoveryShortRest,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.bard.executeBardicInspiration(tt.character)
+			tt.bard.RecoverShortRestTokens()
+
+			if tt.expected.RecoveryType != tt.bard.ClassToken.RecoveryType {
+				t.Errorf("Recovery Type- Expected: %s, Result: %s", tt.expected.RecoveryType, tt.bard.ClassToken.RecoveryType)
+			}
+
+			if tt.expected.Available != tt.bard.ClassToken.Available {
+				t.Errorf("Bardic Inspiration- Expected: %d, Result: %d", tt.expected.Available, tt.bard.ClassToken.Available)
+			}
+		})
+	}
+}
+
+func TestBardExecuteKnownSpells(t *testing.T) {
+	tests := []struct {
+		name             string
+		level            int
+		spells           []shared.CharacterSpell
+		expectedSpells   int
+		expectedCantrips int
+	}{
+		{
+			name:  "Counts spells and cantrips separately",
+			level: 4,
+			spells: []shared.CharacterSpell{
+				{Name: "Vicious Mockery", SlotLevel: 0},
+				{Name: "Sleep", SlotLevel: 1},
+				{Name: "Heroism", SlotLevel: 1, ClassType: shared.ClassBard},
+			},
+			expectedSpells:   2,
+			expectedCantrips: 1,
+		},
+		{
+			name:  "Spells from another class don't count",
+			level: 4,
+			spells: []shared.CharacterSpell{
+				{Name: "Sleep", SlotLevel: 1, ClassType: shared.ClassBard},
+				{Name: "Hex", SlotLevel: 1, ClassType: shared.ClassWarlock},
+			},
+			expectedSpells:   1,
+			expectedCantrips: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bard := &Bard{BaseClass: models.BaseClass{ClassType: shared.ClassBard, Level: tt.level}}
+			character := &models.Character{
+				Spells:  tt.spells,
+				Classes: []models.Class{bard},
+			}
+			bard.executeKnownSpells(character)
+
+			if tt.expectedSpells != bard.SpellsKnownCount {
+				t.Errorf("Spells Known- Expected: %d, Result: %d", tt.expectedSpells, bard.SpellsKnownCount)
+			}
+
+			if tt.expectedCantrips != bard.CantripsKnownCount {
+				t.Errorf("Cantrips Known- Expected: %d, Result: %d", tt.expectedCantrips, bard.CantripsKnownCount)
+			}
+		})
+	}
+}
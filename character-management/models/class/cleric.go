@@ -13,8 +13,9 @@ import (
 
 type Cleric struct {
 	models.BaseClass
-	ClassToken     shared.NamedToken `json:"class-token" clover:"class-token"`
-	PreparedSpells []string          `json:"prepared-spells" clover:"prepared-spells"`
+	ClassToken        shared.NamedToken `json:"class-token" clover:"class-token"`
+	PreparedSpells    []string          `json:"prepared-spells" clover:"prepared-spells"`
+	PreparedSpellsMax int               `json:"-" clover:"-"`
 }
 
 const channelDivinityToken string = "channel-divinity"
@@ -47,6 +48,8 @@ func (cl *Cleric) executeChannelDiversity(c *models.Character) {
 		return
 	}
 
+	cl.ClassToken.RecoveryType = shared.TokenRecoveryShortRest
+
 	switch {
 	case c.Level < 2:
 		cl.ClassToken.Maximum = 0
@@ -102,26 +105,14 @@ func (cl *Cleric) executeCantripVersatility(c *models.Character) {
 }
 
 func (cl *Cleric) executeSpellCastingAbility(c *models.Character) {
-	wisMod := c.GetMod(shared.AbilityWisdom)
-
-	executeSpellSaveDC(c, wisMod)
-	executeSpellAttackMod(c, wisMod)
+	c.SetSpellcasting(cl.ClassType, shared.AbilityWisdom)
 }
 
 func (cl *Cleric) executePreparedSpells(c *models.Character) {
 	wisMod := c.GetMod(shared.AbilityWisdom)
-	preparedSpellsMax := wisMod + c.Level
-
-	if !c.ValidationDisabled {
-		if len(cl.PreparedSpells) > preparedSpellsMax {
-			logger.Info(fmt.Sprintf("%d exceeds the maximum amount of prepared spells (%d)",
-				len(cl.PreparedSpells), preparedSpellsMax))
-		} else if len(cl.PreparedSpells) < preparedSpellsMax {
-			diff := preparedSpellsMax - len(cl.PreparedSpells)
-			logger.Info(fmt.Sprintf("You have %d prepared spells not being used", diff))
-		}
-	}
+	cl.PreparedSpellsMax = max(wisMod+cl.Level, 1)
 
+	validatePreparedSpellsShared(c, cl.PreparedSpells, cl.PreparedSpellsMax)
 	executePreparedSpellsShared(c, cl.PreparedSpells)
 }
 
@@ -163,6 +154,10 @@ func (cl *Cleric) RecoverClassTokens(tokenName string, quantity int) {
 	}
 }
 
+func (cl *Cleric) RecoverShortRestTokens() {
+	recoverShortRestToken(&cl.ClassToken)
+}
+
 func (cl *Cleric) GetTokens() []string {
 	return []string{
 		channelDivinityToken,
@@ -172,7 +167,7 @@ func (cl *Cleric) GetTokens() []string {
 func (cl *Cleric) AddPreparedSpell(spell string) error {
 	for _, ps := range cl.PreparedSpells {
 		if strings.EqualFold(ps, spell) {
-			return fmt.Errorf("Spell 's' already exists as a prepared spell")
+			return fmt.Errorf("Spell '%s' already exists as a prepared spell", spell)
 		}
 	}
 
@@ -195,3 +190,7 @@ func (cl *Cleric) RemovePreparedSpell(spell string) error {
 func (cl *Cleric) GetPreparedSpells() []string {
 	return cl.PreparedSpells
 }
+
+func (cl *Cleric) GetPreparedSpellsMax() int {
+	return cl.PreparedSpellsMax
+}
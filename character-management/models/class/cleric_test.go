@@ -110,3 +110,52 @@ func TestClericExecutePreparedSpells(t *testing.T) {
 		})
 	}
 }
+
+func TestClericExecutePreparedSpellsMax(t *testing.T) {
+	tests := []struct {
+		name           string
+		wisMod         int
+		characterLevel int
+		level          int
+		expected       int
+	}{
+		{
+			name:           "Wisdom mod plus cleric level",
+			wisMod:         3,
+			characterLevel: 4,
+			level:          4,
+			expected:       7,
+		},
+		{
+			name:           "Multiclass only counts cleric levels",
+			wisMod:         2,
+			characterLevel: 8,
+			level:          3,
+			expected:       5,
+		},
+		{
+			name:           "Minimum of one",
+			wisMod:         -1,
+			characterLevel: 1,
+			level:          1,
+			expected:       1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{
+				Level: tt.characterLevel,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityWisdom, AbilityModifier: tt.wisMod},
+				},
+			}
+			cleric := &Cleric{BaseClass: models.BaseClass{Level: tt.level}}
+			cleric.executePreparedSpells(character)
+
+			if tt.expected != cleric.PreparedSpellsMax {
+				t.Errorf("Prepared Spells Max- Expected: %d, Result: %d", tt.expected, cleric.PreparedSpellsMax)
+			}
+		})
+	}
+}
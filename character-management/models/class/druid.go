@@ -13,9 +13,10 @@ import (
 
 type Druid struct {
 	models.BaseClass
-	ClassToken     shared.NamedToken `json:"class-token" clover:"class-token"`
-	Circle         string            `json:"circle" clover:"circle"`
-	PreparedSpells []string          `json:"prepared-spells" clover:"prepared-spells"`
+	ClassToken        shared.NamedToken `json:"class-token" clover:"class-token"`
+	Circle            string            `json:"circle" clover:"circle"`
+	PreparedSpells    []string          `json:"prepared-spells" clover:"prepared-spells"`
+	PreparedSpellsMax int               `json:"-" clover:"-"`
 }
 
 const wildShapeToken string = "wild-shape"
@@ -50,6 +51,7 @@ func (d *Druid) executeWildShape(c *models.Character) {
 	}
 
 	d.ClassToken.Maximum = 2
+	d.ClassToken.RecoveryType = shared.TokenRecoveryShortRest
 }
 
 func (d *Druid) executeCantripVersatility(c *models.Character) {
@@ -93,26 +95,14 @@ func (d *Druid) executeCantripVersatility(c *models.Character) {
 }
 
 func (d *Druid) executeSpellCastingAbility(c *models.Character) {
-	wisMod := c.GetMod(shared.AbilityWisdom)
-
-	executeSpellSaveDC(c, wisMod)
-	executeSpellAttackMod(c, wisMod)
+	c.SetSpellcasting(d.ClassType, shared.AbilityWisdom)
 }
 
 func (d *Druid) executePreparedSpells(c *models.Character) {
 	wisMod := c.GetMod(shared.AbilityWisdom)
-	preparedSpellsMax := wisMod + c.Level
-
-	if !c.ValidationDisabled {
-		if len(d.PreparedSpells) > preparedSpellsMax {
-			logger.Info(fmt.Sprintf("%d exceeds the maximum amount of prepared spells (%d)",
-				len(d.PreparedSpells), preparedSpellsMax))
-		} else if len(d.PreparedSpells) < preparedSpellsMax {
-			diff := preparedSpellsMax - len(d.PreparedSpells)
-			logger.Info(fmt.Sprintf("You have %d prepared spells not being used", diff))
-		}
-	}
+	d.PreparedSpellsMax = max(wisMod+d.Level, 1)
 
+	validatePreparedSpellsShared(c, d.PreparedSpells, d.PreparedSpellsMax)
 	executePreparedSpellsShared(c, d.PreparedSpells)
 }
 
@@ -164,6 +154,10 @@ func (d *Druid) RecoverClassTokens(tokenName string, quantity int) {
 	}
 }
 
+func (d *Druid) RecoverShortRestTokens() {
+	recoverShortRestToken(&d.ClassToken)
+}
+
 func (d *Druid) GetTokens() []string {
 	return []string{
 		wildShapeToken,
@@ -173,7 +167,7 @@ func (d *Druid) GetTokens() []string {
 func (d *Druid) AddPreparedSpell(spell string) error {
 	for _, ps := range d.PreparedSpells {
 		if strings.EqualFold(ps, spell) {
-			return fmt.Errorf("Spell 's' already exists as a prepared spell")
+			return fmt.Errorf("Spell '%s' already exists as a prepared spell", spell)
 		}
 	}
 
@@ -196,3 +190,7 @@ func (d *Druid) RemovePreparedSpell(spell string) error {
 func (d *Druid) GetPreparedSpells() []string {
 	return d.PreparedSpells
 }
+
+func (d *Druid) GetPreparedSpellsMax() int {
+	return d.PreparedSpellsMax
+}
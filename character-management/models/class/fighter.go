@@ -51,8 +51,14 @@ func (f *Fighter) CalculateHitDice() string {
 }
 
 func (f *Fighter) executeClassTokens() {
-	for i := range f.ClassTokens {
+	for i, token := range f.ClassTokens {
 		f.ClassTokens[i].Maximum = 1
+
+		// Indomitable is the only fighter token that isn't recovered on a short rest
+		f.ClassTokens[i].RecoveryType = shared.TokenRecoveryShortRest
+		if token.Name == "indomitable" {
+			f.ClassTokens[i].RecoveryType = shared.TokenRecoveryLongRest
+		}
 	}
 }
 
@@ -165,6 +171,12 @@ func (f *Fighter) RecoverClassTokens(tokenName string, quantity int) {
 	}
 }
 
+func (f *Fighter) RecoverShortRestTokens() {
+	for i := range f.ClassTokens {
+		recoverShortRestToken(&f.ClassTokens[i])
+	}
+}
+
 func (f *Fighter) GetTokens() []string {
 	s := []string{}
 
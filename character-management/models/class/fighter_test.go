@@ -86,3 +86,40 @@ func TestFighterRecoverClassTokens(t *testing.T) {
 		})
 	}
 }
+
+func TestFighterRecoverShortRestTokens(t *testing.T) {
+	tests := []struct {
+		name     string
+		fighter  *Fighter
+		expected []shared.NamedToken
+	}{
+		{
+			name: "Action surge and second wind recovered, indomitable not recovered",
+			fighter: &Fighter{
+				ClassTokens: []shared.NamedToken{
+					{Name: "indomitable", Available: 0, Maximum: 1, Level: 9},
+					{Name: "second-wind", Available: 0, Maximum: 1, Level: 1},
+					{Name: "action-surge", Available: 0, Maximum: 1, Level: 2},
+				},
+			},
+			expected: []shared.NamedToken{
+				{Name: "indomitable", Available: 0},
+				{Name: "second-wind", Available: 1},
+				{Name: "action-surge", Available: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fighter.executeClassTokens()
+			tt.fighter.RecoverShortRestTokens()
+			for i, e := range tt.expected {
+				result := tt.fighter.ClassTokens[i]
+				if e.Available != result.Available {
+					t.Errorf("Token %s- Expected: %d, Result: %d", e.Name, e.Available, result.Available)
+				}
+			}
+		})
+	}
+}
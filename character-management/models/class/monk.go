@@ -32,13 +32,13 @@ func LoadMonk(data []byte) (*Monk, error) {
 func (m *Monk) ExecutePostCalculateMethods(c *models.Character) {
 	m.executeUnarmoredDefense(c)
 	m.executeMartialArts(c)
-	m.executeUnarmoredMovement(c)
 	m.executeDeflectMissles(c)
 	m.executeKiPoints(c)
 }
 
 func (m *Monk) ExecutePreCalculateMethods(c *models.Character) {
 	m.executeDiamondSoul(c)
+	m.executeUnarmoredMovement(c)
 }
 
 func (m *Monk) CalculateHitDice() string {
@@ -59,7 +59,8 @@ func (m *Monk) executeUnarmoredMovement(c *models.Character) {
 		return
 	}
 
-	c.Speed += 10
+	// Applied before stats are calculated so that conditions reducing speed include the bonus
+	c.SpeedAdjusted += 10
 }
 
 func (m *Monk) executeMartialArts(c *models.Character) {
@@ -85,6 +86,7 @@ func (m *Monk) executeKiPoints(c *models.Character) {
 
 	m.ClassToken.Maximum = c.Level
 	m.ClassToken.Available = min(m.ClassToken.Available, m.ClassToken.Maximum)
+	m.ClassToken.RecoveryType = shared.TokenRecoveryShortRest
 
 	wisMod := c.GetMod(shared.AbilityWisdom)
 
@@ -160,6 +162,10 @@ func (m *Monk) RecoverClassTokens(tokenName string, quantity int) {
 	}
 }
 
+func (m *Monk) RecoverShortRestTokens() {
+	recoverShortRestToken(&m.ClassToken)
+}
+
 func (m *Monk) GetTokens() []string {
 	return []string{
 		kiPointsToken,
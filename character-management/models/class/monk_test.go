@@ -120,7 +120,7 @@ func TestMonkExecuteUnarmoredMovement(t *testing.T) {
 				},
 			},
 			character: &models.Character{
-				Speed: 0,
+				SpeedAdjusted: 0,
 				WornEquipment: shared.WornEquipment{
 					Armor: shared.Armor{
 						Name: "Leather Armor",
@@ -137,7 +137,7 @@ func TestMonkExecuteUnarmoredMovement(t *testing.T) {
 				},
 			},
 			character: &models.Character{
-				Speed: 16,
+				SpeedAdjusted: 16,
 				WornEquipment: shared.WornEquipment{
 					Armor: shared.Armor{
 						Name: "",
@@ -151,7 +151,7 @@ func TestMonkExecuteUnarmoredMovement(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.monk.executeUnarmoredMovement(tt.character)
-			result := tt.character.Speed
+			result := tt.character.SpeedAdjusted
 
 			if tt.expected != result {
 				t.Errorf("Speed- Expected: %d, Result: %d", tt.expected, result)
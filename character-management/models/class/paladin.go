@@ -21,6 +21,7 @@ var paladinFightingStyles = []string{
 type Paladin struct {
 	models.BaseClass
 	PreparedSpells       []string             `json:"prepared-spells" clover:"prepared-spells"`
+	PreparedSpellsMax    int                  `json:"-" clover:"-"`
 	OathSpells           []string             `json:"oath-spells" clover:"oath-spells"`
 	ClassTokens          []shared.NamedToken  `json:"class-tokens" clover:"class-tokens"`
 	FightingStyle        string               `json:"fighting-style" clover:"fighting-style"`
@@ -49,14 +50,13 @@ func (p *Paladin) CalculateHitDice() string {
 }
 
 func (s *Paladin) executeSpellCastingAbility(c *models.Character) {
-	chrMod := c.GetMod(shared.AbilityCharisma)
-
-	executeSpellSaveDC(c, chrMod)
-	executeSpellAttackMod(c, chrMod)
+	c.SetSpellcasting(s.ClassType, shared.AbilityCharisma)
 }
 
 func (p *Paladin) executeClassTokens(c *models.Character) {
 	for i, token := range p.ClassTokens {
+		p.ClassTokens[i].RecoveryType = shared.TokenRecoveryLongRest
+
 		if token.Name == "divine-sense" {
 			p.ClassTokens[i].Maximum = 1 + c.GetMod(shared.AbilityCharisma)
 		} else if token.Name == "lay-on-hands" {
@@ -95,18 +95,14 @@ func (p *Paladin) executeFightingStyle(c *models.Character) {
 
 func (p *Paladin) executePreparedSpells(c *models.Character) {
 	chrMod := c.GetMod(shared.AbilityCharisma)
-	preparedSpellsMax := chrMod + (c.Level / 2)
 
-	if !c.ValidationDisabled {
-		if len(p.PreparedSpells) > preparedSpellsMax {
-			logger.Info(fmt.Sprintf("%d exceeds the maximum amount of prepared spells (%d)",
-				len(p.PreparedSpells), preparedSpellsMax))
-		} else if len(p.PreparedSpells) < preparedSpellsMax {
-			diff := preparedSpellsMax - len(p.PreparedSpells)
-			logger.Info(fmt.Sprintf("You have %d prepared spells not being used", diff))
-		}
+	// Paladins don't prepare spells until they can cast them at level 2
+	p.PreparedSpellsMax = 0
+	if p.Level >= 2 {
+		p.PreparedSpellsMax = max(chrMod+(p.Level/2), 1)
 	}
 
+	validatePreparedSpellsShared(c, p.PreparedSpells, p.PreparedSpellsMax)
 	executePreparedSpellsShared(c, p.PreparedSpells)
 }
 
@@ -214,6 +210,12 @@ func (p *Paladin) RecoverClassTokens(tokenName string, quantity int) {
 	}
 }
 
+func (p *Paladin) RecoverShortRestTokens() {
+	for i := range p.ClassTokens {
+		recoverShortRestToken(&p.ClassTokens[i])
+	}
+}
+
 func (p *Paladin) GetTokens() []string {
 	s := []string{}
 
@@ -227,7 +229,7 @@ func (p *Paladin) GetTokens() []string {
 func (p *Paladin) AddPreparedSpell(spell string) error {
 	for _, ps := range p.PreparedSpells {
 		if strings.EqualFold(ps, spell) {
-			return fmt.Errorf("Spell 's' already exists as a prepared spell")
+			return fmt.Errorf("Spell '%s' already exists as a prepared spell", spell)
 		}
 	}
 
@@ -251,6 +253,10 @@ func (p *Paladin) GetPreparedSpells() []string {
 	return p.PreparedSpells
 }
 
+func (p *Paladin) GetPreparedSpellsMax() int {
+	return p.PreparedSpellsMax
+}
+
 func (p *Paladin) ModifyFightingStyle(fightingStyle string) error {
 	invalidMsg := fmt.Sprintf("%s not one of the valid fighting styles", fightingStyle)
 	for _, fs := range paladinFightingStyles {
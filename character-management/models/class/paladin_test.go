@@ -102,8 +102,12 @@ func TestPaladinExecuteFightingStyle(t *testing.T) {
 			}
 
 			for i, e := range tt.expected.Weapons {
-				if e.Bonus != result.Weapons[i].Bonus {
-					t.Errorf("Weapon Bonus %s- Expected: %d, Result: %d", e.Name, e.Bonus, result.Weapons[i].Bonus)
+				if e.AttackBonus != result.Weapons[i].AttackBonus {
+					t.Errorf("Weapon Attack Bonus %s- Expected: %d, Result: %d", e.Name, e.AttackBonus, result.Weapons[i].AttackBonus)
+				}
+
+				if e.DamageBonus != result.Weapons[i].DamageBonus {
+					t.Errorf("Weapon Damage Bonus %s- Expected: %d, Result: %d", e.Name, e.DamageBonus, result.Weapons[i].DamageBonus)
 				}
 			}
 		})
@@ -217,3 +221,48 @@ func TestPaladinExecuteOathSpells(t *testing.T) {
 		})
 	}
 }
+
+func TestPaladinExecutePreparedSpellsMax(t *testing.T) {
+	tests := []struct {
+		name     string
+		chrMod   int
+		level    int
+		expected int
+	}{
+		{
+			name:     "Charisma mod plus half the paladin level",
+			chrMod:   3,
+			level:    5,
+			expected: 5,
+		},
+		{
+			name:     "Minimum of one",
+			chrMod:   -1,
+			level:    2,
+			expected: 1,
+		},
+		{
+			name:     "No prepared spells at level 1",
+			chrMod:   3,
+			level:    1,
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{
+				Level: 10,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityCharisma, AbilityModifier: tt.chrMod},
+				},
+			}
+			paladin := &Paladin{BaseClass: models.BaseClass{Level: tt.level}}
+			paladin.executePreparedSpells(character)
+
+			if tt.expected != paladin.PreparedSpellsMax {
+				t.Errorf("Prepared Spells Max- Expected: %d, Result: %d", tt.expected, paladin.PreparedSpellsMax)
+			}
+		})
+	}
+}
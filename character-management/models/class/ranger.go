@@ -23,8 +23,15 @@ type Ranger struct {
 	FightingStyle        string               `json:"fighting-style" clover:"fighting-style"`
 	FightingStyleFeature FightingStyleFeature `json:"-" clover:"-"`
 	FavoredEnemies       []string             `json:"favored-enemies" clover:"favored-enemies"`
+	SpellSwap            bool                 `json:"spell-swap" clover:"spell-swap"`
+	SpellsKnownCount     int                  `json:"-" clover:"-"`
+	CantripsKnownCount   int                  `json:"-" clover:"-"`
 }
 
+// Spells known by ranger level, indexed by ranger level - 1. Rangers don't learn cantrips
+var rangerSpellsKnown = [shared.MaxLevel]int{0, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11}
+var rangerCantripsKnown = [shared.MaxLevel]int{}
+
 func LoadRanger(data []byte) (*Ranger, error) {
 	var ranger Ranger
 	if err := json.Unmarshal(data, &ranger); err != nil {
@@ -37,23 +44,26 @@ func LoadRanger(data []byte) (*Ranger, error) {
 func (r *Ranger) ExecutePostCalculateMethods(c *models.Character) {
 	r.executeSpellCastingAbility(c)
 	r.executeFightingStyle(c)
+	r.executeKnownSpells(c)
 }
 
 func (r *Ranger) CalculateHitDice() string {
 	return fmt.Sprintf("%dd10", r.Level)
 }
 
-func (r *Ranger) executeSpellCastingAbility(c *models.Character) {
-	wisMod := c.GetMod(shared.AbilityWisdom)
+func (r *Ranger) executeKnownSpells(c *models.Character) {
+	r.SpellsKnownCount, r.CantripsKnownCount = executeKnownSpellsShared(c, r.ClassType, r.GetSpellsKnown(), r.GetCantripsKnown())
+}
 
-	executeSpellSaveDC(c, wisMod)
-	executeSpellAttackMod(c, wisMod)
+func (r *Ranger) executeSpellCastingAbility(c *models.Character) {
+	c.SetSpellcasting(r.ClassType, shared.AbilityWisdom)
 }
 
 func (r *Ranger) ClassDetails() string {
 	var s string
 
 	s += fmt.Sprintf("Level: %d\n", r.Level)
+	s += formatKnownSpells(r.SpellsKnownCount, r.GetSpellsKnown(), r.CantripsKnownCount, r.GetCantripsKnown(), r.SpellSwap)
 
 	if r.FightingStyleFeature.Name != "" && r.Level >= 2 {
 		appliedText := "Requirements for fighting style not met."
@@ -144,3 +154,19 @@ func (r *Ranger) RemoveFavoredEnemy(favoredEnemy string) error {
 
 	return fmt.Errorf("Favored enemy '%s' not found in list of favored enemies", favoredEnemy)
 }
+
+func (r *Ranger) GetSpellsKnown() int {
+	return getKnownByLevel(rangerSpellsKnown, r.Level)
+}
+
+func (r *Ranger) GetCantripsKnown() int {
+	return getKnownByLevel(rangerCantripsKnown, r.Level)
+}
+
+func (r *Ranger) GetSpellSwap() bool {
+	return r.SpellSwap
+}
+
+func (r *Ranger) SetSpellSwap(available bool) {
+	r.SpellSwap = available
+}
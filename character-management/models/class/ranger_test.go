@@ -102,9 +102,55 @@ func TestRangerExecuteFightingStyle(t *testing.T) {
 			}
 
 			for i, e := range tt.expected.Weapons {
-				if e.Bonus != result.Weapons[i].Bonus {
-					t.Errorf("Weapon Bonus %s- Expected: %d, Result: %d", e.Name, e.Bonus, result.Weapons[i].Bonus)
+				if e.AttackBonus != result.Weapons[i].AttackBonus {
+					t.Errorf("Weapon Attack Bonus %s- Expected: %d, Result: %d", e.Name, e.AttackBonus, result.Weapons[i].AttackBonus)
 				}
+
+				if e.DamageBonus != result.Weapons[i].DamageBonus {
+					t.Errorf("Weapon Damage Bonus %s- Expected: %d, Result: %d", e.Name, e.DamageBonus, result.Weapons[i].DamageBonus)
+				}
+			}
+		})
+	}
+}
+
+func TestRangerGetSpellsKnown(t *testing.T) {
+	tests := []struct {
+		name             string
+		level            int
+		expectedSpells   int
+		expectedCantrips int
+	}{
+		{
+			name:             "No spells at level 1",
+			level:            1,
+			expectedSpells:   0,
+			expectedCantrips: 0,
+		},
+		{
+			name:             "Level 2",
+			level:            2,
+			expectedSpells:   2,
+			expectedCantrips: 0,
+		},
+		{
+			name:             "Level 20",
+			level:            20,
+			expectedSpells:   11,
+			expectedCantrips: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranger := &Ranger{BaseClass: models.BaseClass{Level: tt.level}}
+
+			if tt.expectedSpells != ranger.GetSpellsKnown() {
+				t.Errorf("Spells Known- Expected: %d, Result: %d", tt.expectedSpells, ranger.GetSpellsKnown())
+			}
+
+			if tt.expectedCantrips != ranger.GetCantripsKnown() {
+				t.Errorf("Cantrips Known- Expected: %d, Result: %d", tt.expectedCantrips, ranger.GetCantripsKnown())
 			}
 		})
 	}
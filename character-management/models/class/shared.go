@@ -56,7 +56,7 @@ func executeUnarmoredDefenseShared(c *models.Character, abilities []string) {
 		}
 	}
 
-	c.AC += 10
+	c.AC += 10 + c.GetMagicItemACBonus()
 }
 
 func executePreparedSpellsShared(c *models.Character, preparedSpells []string) {
@@ -69,12 +69,63 @@ func executePreparedSpellsShared(c *models.Character, preparedSpells []string) {
 	}
 }
 
-func executeSpellSaveDC(c *models.Character, abilityMod int) {
-	c.SpellSaveDC = 8 + c.Proficiency + abilityMod
+// Prepared spells over the maximum are only a warning here, since they may have been added with validation disabled
+func validatePreparedSpellsShared(c *models.Character, preparedSpells []string, preparedSpellsMax int) {
+	if c.ValidationDisabled {
+		return
+	}
+
+	if len(preparedSpells) > preparedSpellsMax {
+		logger.Info(fmt.Sprintf("%d exceeds the maximum amount of prepared spells (%d)",
+			len(preparedSpells), preparedSpellsMax))
+	} else if len(preparedSpells) < preparedSpellsMax {
+		diff := preparedSpellsMax - len(preparedSpells)
+		logger.Info(fmt.Sprintf("You have %d prepared spells not being used", diff))
+	}
+}
+
+// Known spells over the maximum are only a warning here, since they may have been added with validation disabled
+func executeKnownSpellsShared(c *models.Character, classType string, spellsKnownMax int, cantripsKnownMax int) (int, int) {
+	spellsKnown, cantripsKnown := c.GetKnownSpellCounts(classType)
+
+	if !c.ValidationDisabled {
+		if spellsKnown > spellsKnownMax {
+			logger.Info(fmt.Sprintf("%d exceeds the maximum amount of %s spells known (%d)", spellsKnown, classType, spellsKnownMax))
+		}
+
+		if cantripsKnown > cantripsKnownMax {
+			logger.Info(fmt.Sprintf("%d exceeds the maximum amount of %s cantrips known (%d)", cantripsKnown, classType, cantripsKnownMax))
+		}
+	}
+
+	return spellsKnown, cantripsKnown
+}
+
+// Looks up a per level table, indexed by class level - 1
+func getKnownByLevel(table [shared.MaxLevel]int, level int) int {
+	if level < 1 {
+		return 0
+	}
+
+	return table[min(level, shared.MaxLevel)-1]
 }
 
-func executeSpellAttackMod(c *models.Character, abilityMod int) {
-	c.SpellAttackMod = c.Proficiency + abilityMod
+func formatKnownSpells(spellsKnown int, spellsKnownMax int, cantripsKnown int, cantripsKnownMax int, spellSwap bool) string {
+	var s string
+
+	if cantripsKnownMax > 0 {
+		s += fmt.Sprintf("Cantrips Known: %d/%d\n", cantripsKnown, cantripsKnownMax)
+	}
+
+	if spellsKnownMax > 0 {
+		s += fmt.Sprintf("Spells Known: %d/%d\n", spellsKnown, spellsKnownMax)
+	}
+
+	if spellSwap {
+		s += "Spell swap available from leveling up\n"
+	}
+
+	return s
 }
 
 // Applies bonus for fighting style, and returns feature with details and weather or not the feature was applied
@@ -87,9 +138,8 @@ func applyArchery(c *models.Character) FightingStyleFeature {
 
 	for i, weapon := range c.Weapons {
 		if weapon.Ranged {
-			c.Weapons[i].Bonus += 2
+			c.Weapons[i].AttackBonus += 2
 			feature.IsApplied = true
-			break
 		}
 	}
 
@@ -145,7 +195,7 @@ func applyDueling(c *models.Character) FightingStyleFeature {
 		}
 
 		if !isTwoHanded {
-			c.Weapons[i].Bonus += 2
+			c.Weapons[i].DamageBonus += 2
 			feature.IsApplied = true
 			break
 		}
@@ -156,83 +206,21 @@ func applyDueling(c *models.Character) FightingStyleFeature {
 
 // Applies bonus for fighting style, and returns feature with details and weather or not the feature was applied
 func applyTwoWeaponFighting(c *models.Character) FightingStyleFeature {
-	// This is somewhat nuanced, so I'm just going to document how this works for clarity
-	// When dual wielding, there is a primary weapon that must be one handed, and an off hand
-	// weapon that must be one handed and have the "light" property. The primary weapon can be
-	// light, but does not have to be. The dexterity bonus while dual weilding applies to the
-	// off hand weapon. For our purposes, we'll consider the first weapon that meets both
-	// criteria the "secondary" weapon, and the the next one to meet just the one handed criteria
-	// the primary. We'll only apply the bonus if both primary and secondary weapons are found
 	feature := FightingStyleFeature{
 		Name:      "Two Weapon Fighting",
 		Details:   "When you engage in two-weapon fighting, you can add your ability modifier to the damage of the second attack.\n",
 		IsApplied: false,
 	}
 
-	// If one of these isn't equipped no need to add the bonus
-	if c.PrimaryEquipped == "" || c.SecondaryEquipped == "" {
-		return feature
-	}
-
-	secondaryWeaponIndex := -1
-	primaryWeaponIndex := -1
-
-	for i, weapon := range c.Weapons {
-		isLight := false
-		isOneHanded := true
-
-		if strings.ToLower(c.PrimaryEquipped) != strings.ToLower(weapon.Name) &&
-			strings.ToLower(c.SecondaryEquipped) != strings.ToLower(weapon.Name) {
-			continue
-		}
-
-		for _, prop := range weapon.Properties {
-			if strings.ToLower(prop) == shared.WeaponPropertyTwoHanded {
-				isOneHanded = false
-				break
-			}
-			if strings.ToLower(prop) == shared.WeaponPropertyLight {
-				isLight = true
-			}
-		}
-
-		if !isOneHanded {
-			continue
-		}
-
-		// We'll take the first secondary weapon that meets these criteria since I'm not sure
-		// that primary vs secondary weapons really matter for this distinction as long
-		// as the requirements are met
-		if isOneHanded && isLight && secondaryWeaponIndex == -1 {
-			secondaryWeaponIndex = i
-			if primaryWeaponIndex != -1 {
-				break
-			}
-			continue
-		}
-
-		// The first weapon that meets this criteria will be considered the primary
-		if isOneHanded {
-			primaryWeaponIndex = i
-		}
-
-		// Once both are set we don't need to continue looping over weapons
-		if primaryWeaponIndex != -1 && secondaryWeaponIndex != -1 {
-			break
-		}
-	}
-
-	if primaryWeaponIndex == -1 || secondaryWeaponIndex == -1 {
+	// The off hand weapon is left without its ability modifier when weapon bonuses are calculated,
+	// so we only need to add a positive modifier back (a negative one is never removed)
+	offHand := c.GetOffHandWeaponIndex()
+	if offHand == -1 {
 		return feature
 	}
 
-	for _, mod := range c.Abilities {
-		if strings.ToLower(mod.Name) == shared.AbilityDexterity {
-			c.Weapons[secondaryWeaponIndex].Bonus += mod.AbilityModifier
-			feature.IsApplied = true
-			break
-		}
-	}
+	c.Weapons[offHand].DamageBonus += max(c.GetWeaponAbilityMod(c.Weapons[offHand]), 0)
+	feature.IsApplied = true
 
 	return feature
 }
@@ -279,6 +267,13 @@ func fullTokenRecovery(tokens []shared.NamedToken) {
 	}
 }
 
+// Refills a token if it's recovered on a short rest, long rest recovery is handled by RecoverClassTokens
+func recoverShortRestToken(token *shared.NamedToken) {
+	if token.RecoveryType == shared.TokenRecoveryShortRest {
+		token.Available = token.Maximum
+	}
+}
+
 func formatTokens(token shared.NamedToken, tokenName string, level int) string {
 	var s string
 
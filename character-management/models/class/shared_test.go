@@ -266,13 +266,13 @@ func TestClassAppliedArchery(t *testing.T) {
 			name: "No ranged weapon",
 			character: &models.Character{
 				Weapons: []shared.Weapon{
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
-					{Name: "Dagger", Bonus: 2, Damage: "1d4", Ranged: false},
+					{Name: "Club", AttackBonus: 2, Damage: "1d4", Ranged: false},
+					{Name: "Dagger", AttackBonus: 2, Damage: "1d4", Ranged: false},
 				},
 			},
 			expected: []shared.Weapon{
-				{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
-				{Name: "Dagger", Bonus: 2, Damage: "1d4", Ranged: false},
+				{Name: "Club", AttackBonus: 2, Damage: "1d4", Ranged: false},
+				{Name: "Dagger", AttackBonus: 2, Damage: "1d4", Ranged: false},
 			},
 			applied: false,
 		},
@@ -280,13 +280,27 @@ func TestClassAppliedArchery(t *testing.T) {
 			name: "Range bonus applied",
 			character: &models.Character{
 				Weapons: []shared.Weapon{
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
-					{Name: "Longbow", Bonus: 2, Damage: "1d8", Ranged: true},
+					{Name: "Club", AttackBonus: 2, Damage: "1d4", Ranged: false},
+					{Name: "Longbow", AttackBonus: 2, Damage: "1d8", Ranged: true},
 				},
 			},
 			expected: []shared.Weapon{
-				{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
-				{Name: "Longbow", Bonus: 4, Damage: "1d8", Ranged: true},
+				{Name: "Club", AttackBonus: 2, Damage: "1d4", Ranged: false},
+				{Name: "Longbow", AttackBonus: 4, Damage: "1d8", Ranged: true},
+			},
+			applied: true,
+		},
+		{
+			name: "Range bonus applied to every ranged weapon",
+			character: &models.Character{
+				Weapons: []shared.Weapon{
+					{Name: "Longbow", AttackBonus: 2, DamageBonus: 2, Damage: "1d8", Ranged: true},
+					{Name: "Sling", AttackBonus: 2, DamageBonus: 2, Damage: "1d4", Ranged: true},
+				},
+			},
+			expected: []shared.Weapon{
+				{Name: "Longbow", AttackBonus: 4, DamageBonus: 2, Damage: "1d8", Ranged: true},
+				{Name: "Sling", AttackBonus: 4, DamageBonus: 2, Damage: "1d4", Ranged: true},
 			},
 			applied: true,
 		},
@@ -298,8 +312,12 @@ func TestClassAppliedArchery(t *testing.T) {
 			result := tt.character.Weapons
 
 			for i, e := range tt.expected {
-				if e.Bonus != result[i].Bonus {
-					t.Errorf("Weapon %s Bonus- Expected: %d, Result: %d", e.Name, e.Bonus, result[i].Bonus)
+				if e.AttackBonus != result[i].AttackBonus {
+					t.Errorf("Weapon %s Attack Bonus- Expected: %d, Result: %d", e.Name, e.AttackBonus, result[i].AttackBonus)
+				}
+
+				if e.DamageBonus != result[i].DamageBonus {
+					t.Errorf("Weapon %s Damage Bonus- Expected: %d, Result: %d", e.Name, e.DamageBonus, result[i].DamageBonus)
 				}
 			}
 
@@ -372,12 +390,12 @@ func TestClassAppliedDueling(t *testing.T) {
 			name: "No melee weapon",
 			character: &models.Character{
 				Weapons: []shared.Weapon{
-					{Name: "Longbow", Bonus: 2, Damage: "1d8", Ranged: true},
+					{Name: "Longbow", DamageBonus: 2, Damage: "1d8", Ranged: true},
 				},
 				PrimaryEquipped: "Longbow",
 			},
 			expected: []shared.Weapon{
-				{Name: "Longbow", Bonus: 2, Damage: "1d8", Ranged: true},
+				{Name: "Longbow", DamageBonus: 2, Damage: "1d8", Ranged: true},
 			},
 			applied: false,
 		},
@@ -385,14 +403,14 @@ func TestClassAppliedDueling(t *testing.T) {
 			name: "Melee bonus applied",
 			character: &models.Character{
 				Weapons: []shared.Weapon{
-					{Name: "Greataxe", Bonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
+					{Name: "Greataxe", DamageBonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
+					{Name: "Club", DamageBonus: 2, Damage: "1d4", Ranged: false},
 				},
 				PrimaryEquipped: "Club",
 			},
 			expected: []shared.Weapon{
-				{Name: "Greataxe", Bonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
-				{Name: "Club", Bonus: 4, Damage: "1d4", Ranged: false},
+				{Name: "Greataxe", DamageBonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
+				{Name: "Club", DamageBonus: 4, Damage: "1d4", Ranged: false},
 			},
 			applied: true,
 		},
@@ -400,16 +418,16 @@ func TestClassAppliedDueling(t *testing.T) {
 			name: "Multiple valid weapons, one bonus",
 			character: &models.Character{
 				Weapons: []shared.Weapon{
-					{Name: "Greataxe", Bonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
+					{Name: "Greataxe", DamageBonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
+					{Name: "Club", DamageBonus: 2, Damage: "1d4", Ranged: false},
+					{Name: "Club", DamageBonus: 2, Damage: "1d4", Ranged: false},
 				},
 				PrimaryEquipped: "Club",
 			},
 			expected: []shared.Weapon{
-				{Name: "Greataxe", Bonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
-				{Name: "Club", Bonus: 4, Damage: "1d4", Ranged: false},
-				{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
+				{Name: "Greataxe", DamageBonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
+				{Name: "Club", DamageBonus: 4, Damage: "1d4", Ranged: false},
+				{Name: "Club", DamageBonus: 2, Damage: "1d4", Ranged: false},
 			},
 			applied: true,
 		},
@@ -421,8 +439,8 @@ func TestClassAppliedDueling(t *testing.T) {
 			result := tt.character.Weapons
 
 			for i, e := range tt.expected {
-				if e.Bonus != result[i].Bonus {
-					t.Errorf("Weapon %s Bonus- Expected: %d, Result: %d", e.Name, e.Bonus, result[i].Bonus)
+				if e.DamageBonus != result[i].DamageBonus {
+					t.Errorf("Weapon %s Bonus- Expected: %d, Result: %d", e.Name, e.DamageBonus, result[i].DamageBonus)
 				}
 			}
 
@@ -447,14 +465,14 @@ func TestClassAppliedTwoWeaponFighting(t *testing.T) {
 					{Name: "Dexterity", Base: 14, AbilityModifier: 2},
 				},
 				Weapons: []shared.Weapon{
-					{Name: "Greataxe", Bonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
-					{Name: "Longbow", Bonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
+					{Name: "Greataxe", DamageBonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
+					{Name: "Longbow", DamageBonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
 				},
 				PrimaryEquipped: "Greataxe",
 			},
 			expected: []shared.Weapon{
-				{Name: "Greataxe", Bonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
-				{Name: "Longbow", Bonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
+				{Name: "Greataxe", DamageBonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
+				{Name: "Longbow", DamageBonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
 			},
 			applied: false,
 		},
@@ -465,14 +483,14 @@ func TestClassAppliedTwoWeaponFighting(t *testing.T) {
 					{Name: "Dexterity", Base: 14, AbilityModifier: 2},
 				},
 				Weapons: []shared.Weapon{
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
-					{Name: "Longbow", Bonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
+					{Name: "Club", DamageBonus: 2, Damage: "1d4", Ranged: false},
+					{Name: "Longbow", DamageBonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
 				},
 				PrimaryEquipped: "Longbow",
 			},
 			expected: []shared.Weapon{
-				{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
-				{Name: "Longbow", Bonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
+				{Name: "Club", DamageBonus: 2, Damage: "1d4", Ranged: false},
+				{Name: "Longbow", DamageBonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
 			},
 			applied: false,
 		},
@@ -483,15 +501,15 @@ func TestClassAppliedTwoWeaponFighting(t *testing.T) {
 					{Name: "Dexterity", Base: 14, AbilityModifier: 2},
 				},
 				Weapons: []shared.Weapon{
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
+					{Name: "Dagger", DamageBonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"finesse", "light"}},
+					{Name: "Dagger", DamageBonus: 0, Damage: "1d4", Ranged: false, Properties: []string{"finesse", "light"}},
 				},
-				PrimaryEquipped:   "Club",
-				SecondaryEquipped: "Club",
+				PrimaryEquipped:   "Dagger",
+				SecondaryEquipped: "Dagger",
 			},
 			expected: []shared.Weapon{
-				{Name: "Club", Bonus: 4, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
-				{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
+				{Name: "Dagger", DamageBonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"finesse", "light"}},
+				{Name: "Dagger", DamageBonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"finesse", "light"}},
 			},
 			applied: true,
 		},
@@ -502,15 +520,15 @@ func TestClassAppliedTwoWeaponFighting(t *testing.T) {
 					{Name: "Dexterity", Base: 14, AbilityModifier: 2},
 				},
 				Weapons: []shared.Weapon{
-					{Name: "Rapier", Bonus: 2, Damage: "1d8", Ranged: false, Properties: []string{"finesse"}},
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
+					{Name: "Rapier", DamageBonus: 2, Damage: "1d8", Ranged: false, Properties: []string{"finesse"}},
+					{Name: "Shortsword", DamageBonus: 0, Damage: "1d6", Ranged: false, Properties: []string{"finesse", "light"}},
 				},
 				PrimaryEquipped:   "Rapier",
-				SecondaryEquipped: "Club",
+				SecondaryEquipped: "Shortsword",
 			},
 			expected: []shared.Weapon{
-				{Name: "Rapier", Bonus: 2, Damage: "1d8", Ranged: false, Properties: []string{"finesse"}},
-				{Name: "Club", Bonus: 4, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
+				{Name: "Rapier", DamageBonus: 2, Damage: "1d8", Ranged: false, Properties: []string{"finesse"}},
+				{Name: "Shortsword", DamageBonus: 2, Damage: "1d6", Ranged: false, Properties: []string{"finesse", "light"}},
 			},
 			applied: true,
 		},
@@ -522,8 +540,8 @@ func TestClassAppliedTwoWeaponFighting(t *testing.T) {
 			result := tt.character.Weapons
 
 			for i, e := range tt.expected {
-				if e.Bonus != result[i].Bonus {
-					t.Errorf("Weapon %s Bonus- Expected: %d, Result: %d", e.Name, e.Bonus, result[i].Bonus)
+				if e.DamageBonus != result[i].DamageBonus {
+					t.Errorf("Weapon %s Bonus- Expected: %d, Result: %d", e.Name, e.DamageBonus, result[i].DamageBonus)
 				}
 			}
 
@@ -534,6 +552,58 @@ func TestClassAppliedTwoWeaponFighting(t *testing.T) {
 	}
 }
 
+func TestClassTwoWeaponFightingOffHandDamage(t *testing.T) {
+	tests := []struct {
+		name             string
+		style            bool
+		expectedMainHand int
+		expectedOffHand  int
+	}{
+		{
+			name:             "Without two weapon fighting, off hand gets no ability modifier",
+			style:            false,
+			expectedMainHand: 3,
+			expectedOffHand:  0,
+		},
+		{
+			name:             "With two weapon fighting, off hand gets ability modifier",
+			style:            true,
+			expectedMainHand: 3,
+			expectedOffHand:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &models.Character{
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityStrength, Base: 10},
+					{Name: shared.AbilityDexterity, Base: 16},
+				},
+				Weapons: []shared.Weapon{
+					{Name: "Scimitar", Damage: "1d6", Properties: []string{shared.WeaponPropertyFinesse, shared.WeaponPropertyLight}},
+					{Name: "Shortsword", Damage: "1d6", Properties: []string{shared.WeaponPropertyFinesse, shared.WeaponPropertyLight}},
+				},
+				PrimaryEquipped:   "Shortsword",
+				SecondaryEquipped: "Scimitar",
+			}
+
+			c.CalculateCharacterStats()
+			if tt.style {
+				applyTwoWeaponFighting(c)
+			}
+
+			if tt.expectedOffHand != c.Weapons[0].DamageBonus {
+				t.Errorf("Off Hand Damage Bonus- Expected: %d, Result: %d", tt.expectedOffHand, c.Weapons[0].DamageBonus)
+			}
+
+			if tt.expectedMainHand != c.Weapons[1].DamageBonus {
+				t.Errorf("Main Hand Damage Bonus- Expected: %d, Result: %d", tt.expectedMainHand, c.Weapons[1].DamageBonus)
+			}
+		})
+	}
+}
+
 func TestClassAppliedGreatWeaponFighting(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -544,8 +614,8 @@ func TestClassAppliedGreatWeaponFighting(t *testing.T) {
 			name: "Two handed equipped, bonus applied",
 			character: &models.Character{
 				Weapons: []shared.Weapon{
-					{Name: "Greataxe", Bonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
-					{Name: "Longbow", Bonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
+					{Name: "Greataxe", DamageBonus: 2, Damage: "1d12", Ranged: false, Properties: []string{"two-handed"}},
+					{Name: "Longbow", DamageBonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
 				},
 				PrimaryEquipped: "Greataxe",
 			},
@@ -555,8 +625,8 @@ func TestClassAppliedGreatWeaponFighting(t *testing.T) {
 			name: "Two handed secondary equipped, bonus applied",
 			character: &models.Character{
 				Weapons: []shared.Weapon{
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false},
-					{Name: "Longbow", Bonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
+					{Name: "Club", DamageBonus: 2, Damage: "1d4", Ranged: false},
+					{Name: "Longbow", DamageBonus: 2, Damage: "1d8", Ranged: true, Properties: []string{"two-handed"}},
 				},
 				SecondaryEquipped: "Longbow",
 			},
@@ -566,8 +636,8 @@ func TestClassAppliedGreatWeaponFighting(t *testing.T) {
 			name: "No applicable weapons, bonus not applied",
 			character: &models.Character{
 				Weapons: []shared.Weapon{
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
-					{Name: "Club", Bonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
+					{Name: "Club", DamageBonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
+					{Name: "Club", DamageBonus: 2, Damage: "1d4", Ranged: false, Properties: []string{"light"}},
 				},
 				PrimaryEquipped:   "Club",
 				SecondaryEquipped: "Club",
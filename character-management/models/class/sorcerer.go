@@ -3,6 +3,7 @@ package class
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/onioncall/dndgo/character-management/models"
 	"github.com/onioncall/dndgo/character-management/shared"
@@ -11,10 +12,17 @@ import (
 
 type Sorcerer struct {
 	models.BaseClass
-	ClassToken      shared.NamedToken     `json:"class-token" clover:"class-token"`
-	MetaMagicSpells []models.ClassFeature `json:"meta-magic-spells" clover:"meta-magic-spells"`
+	ClassToken         shared.NamedToken     `json:"class-token" clover:"class-token"`
+	MetaMagicSpells    []models.ClassFeature `json:"meta-magic-spells" clover:"meta-magic-spells"`
+	SpellSwap          bool                  `json:"spell-swap" clover:"spell-swap"`
+	SpellsKnownCount   int                   `json:"-" clover:"-"`
+	CantripsKnownCount int                   `json:"-" clover:"-"`
 }
 
+// Spells and cantrips known by sorcerer level, indexed by sorcerer level - 1
+var sorcererSpellsKnown = [shared.MaxLevel]int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 12, 13, 13, 14, 14, 15, 15, 15, 15}
+var sorcererCantripsKnown = [shared.MaxLevel]int{4, 4, 4, 5, 5, 5, 5, 5, 5, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6}
+
 const sorceryPointsToken string = "sorcery-points"
 
 func LoadSorcerer(data []byte) (*Sorcerer, error) {
@@ -29,28 +37,35 @@ func LoadSorcerer(data []byte) (*Sorcerer, error) {
 func (s *Sorcerer) ExecutePostCalculateMethods(c *models.Character) {
 	s.executeSpellCastingAbility(c)
 	s.executeSorceryPoints(c)
+	s.executeKnownSpells(c)
 }
 
 func (s *Sorcerer) CalculateHitDice() string {
 	return fmt.Sprintf("%dd6", s.Level)
 }
 
-func (s *Sorcerer) executeSpellCastingAbility(c *models.Character) {
-	chrMod := c.GetMod(shared.AbilityCharisma)
+func (s *Sorcerer) executeKnownSpells(c *models.Character) {
+	s.SpellsKnownCount, s.CantripsKnownCount = executeKnownSpellsShared(c, s.ClassType, s.GetSpellsKnown(), s.GetCantripsKnown())
+}
 
-	executeSpellSaveDC(c, chrMod)
-	executeSpellAttackMod(c, chrMod)
+func (s *Sorcerer) executeSpellCastingAbility(c *models.Character) {
+	c.SetSpellcasting(s.ClassType, shared.AbilityCharisma)
 }
 
+// Sorcery points start at 2nd level, with one point per sorcerer level
 func (s *Sorcerer) executeSorceryPoints(c *models.Character) {
-	s.ClassToken.Maximum = 2
-	s.ClassToken.Maximum += c.Level
+	s.ClassToken.Maximum = 0
+	if s.Level >= 2 {
+		s.ClassToken.Maximum = s.Level
+	}
+	s.ClassToken.RecoveryType = shared.TokenRecoveryLongRest
 }
 
 func (s *Sorcerer) ClassDetails() string {
 	var str string
 
 	str += fmt.Sprintf("Level: %d\n", s.Level)
+	str += formatKnownSpells(s.SpellsKnownCount, s.GetSpellsKnown(), s.CantripsKnownCount, s.GetCantripsKnown(), s.SpellSwap)
 
 	if s.Level >= 2 && s.ClassToken.Name == sorceryPointsToken {
 		str += fmt.Sprintf("*Sorcery Points*: %d/%d\n\n", s.ClassToken.Available, s.ClassToken.Maximum)
@@ -102,8 +117,89 @@ func (s *Sorcerer) RecoverClassTokens(tokenName string, quantity int) {
 	}
 }
 
+func (s *Sorcerer) RecoverShortRestTokens() {
+	recoverShortRestToken(&s.ClassToken)
+}
+
 func (s *Sorcerer) GetTokens() []string {
 	return []string{
 		sorceryPointsToken,
 	}
 }
+
+func (s *Sorcerer) GetSorceryPoints() int {
+	return s.ClassToken.Available
+}
+
+func (s *Sorcerer) SpendSorceryPoints(points int) error {
+	if s.ClassToken.Maximum == 0 {
+		return fmt.Errorf("Sorcery points are gained at sorcerer level 2")
+	}
+
+	if points > s.ClassToken.Available {
+		return fmt.Errorf("Only %d sorcery points available, %d needed", s.ClassToken.Available, points)
+	}
+
+	s.ClassToken.Available -= points
+	return nil
+}
+
+func (s *Sorcerer) GainSorceryPoints(points int) error {
+	if s.ClassToken.Available+points > s.ClassToken.Maximum {
+		return fmt.Errorf("Sorcery points can't go above the maximum of %d, %d available", s.ClassToken.Maximum, s.ClassToken.Available)
+	}
+
+	s.ClassToken.Available += points
+	return nil
+}
+
+// Metamagic can be given with or without 'spell', ex: 'quickened' or 'quickened spell'
+func (s *Sorcerer) GetMetamagicCost(metamagic string, spellLevel int) (int, error) {
+	if s.Level < 3 {
+		return 0, fmt.Errorf("Metamagic is gained at sorcerer level 3")
+	}
+
+	metamagic = strings.ToLower(strings.TrimSpace(metamagic))
+	if !strings.HasSuffix(metamagic, " spell") {
+		metamagic += " spell"
+	}
+
+	known := false
+	for _, mm := range s.MetaMagicSpells {
+		if strings.EqualFold(mm.Name, metamagic) {
+			known = true
+			break
+		}
+	}
+
+	if !known {
+		return 0, fmt.Errorf("Metamagic '%s' not found in metamagic spells, check spelling", metamagic)
+	}
+
+	if metamagic == shared.MetamagicTwinned {
+		return max(spellLevel, 1), nil
+	}
+
+	cost, ok := shared.MetamagicCost[metamagic]
+	if !ok {
+		return 0, fmt.Errorf("No sorcery point cost known for metamagic '%s'", metamagic)
+	}
+
+	return cost, nil
+}
+
+func (s *Sorcerer) GetSpellsKnown() int {
+	return getKnownByLevel(sorcererSpellsKnown, s.Level)
+}
+
+func (s *Sorcerer) GetCantripsKnown() int {
+	return getKnownByLevel(sorcererCantripsKnown, s.Level)
+}
+
+func (s *Sorcerer) GetSpellSwap() bool {
+	return s.SpellSwap
+}
+
+func (s *Sorcerer) SetSpellSwap(available bool) {
+	s.SpellSwap = available
+}
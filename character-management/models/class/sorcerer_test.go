@@ -56,3 +56,106 @@ func TestSorcererExecuteSpellCastingAbility(t *testing.T) {
 		})
 	}
 }
+
+func TestSorcererGetMetamagicCost(t *testing.T) {
+	metamagic := []models.ClassFeature{
+		{Name: "Quickened Spell", Level: 3},
+		{Name: "Twinned Spell", Level: 3},
+	}
+
+	tests := []struct {
+		name       string
+		level      int
+		metamagic  string
+		spellLevel int
+		expected   int
+		expectErr  bool
+	}{
+		{
+			name:       "Quickened without 'spell'",
+			level:      3,
+			metamagic:  "quickened",
+			spellLevel: 3,
+			expected:   2,
+		},
+		{
+			name:       "Twinned costs the spell level",
+			level:      5,
+			metamagic:  "Twinned Spell",
+			spellLevel: 3,
+			expected:   3,
+		},
+		{
+			name:       "Twinned cantrip",
+			level:      5,
+			metamagic:  "twinned",
+			spellLevel: 0,
+			expected:   1,
+		},
+		{
+			name:       "Metamagic not known",
+			level:      5,
+			metamagic:  "heightened",
+			spellLevel: 3,
+			expectErr:  true,
+		},
+		{
+			name:       "Below level 3",
+			level:      2,
+			metamagic:  "quickened",
+			spellLevel: 1,
+			expectErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorcerer := &Sorcerer{
+				BaseClass:       models.BaseClass{Level: tt.level},
+				MetaMagicSpells: metamagic,
+			}
+
+			result, err := sorcerer.GetMetamagicCost(tt.metamagic, tt.spellLevel)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error for metamagic '%s'", tt.metamagic)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if tt.expected != result {
+				t.Errorf("Cost- Expected: %d, Result: %d", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSorcererExecuteSorceryPoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    int
+		expected int
+	}{
+		{
+			name:     "No sorcery points at level 1",
+			level:    1,
+			expected: 0,
+		},
+		{
+			name:     "One point per sorcerer level",
+			level:    7,
+			expected: 7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorcerer := &Sorcerer{BaseClass: models.BaseClass{Level: tt.level}}
+			sorcerer.executeSorceryPoints(&models.Character{Level: tt.level + 3})
+
+			if tt.expected != sorcerer.ClassToken.Maximum {
+				t.Errorf("Sorcery Points Maximum- Expected: %d, Result: %d", tt.expected, sorcerer.ClassToken.Maximum)
+			}
+		})
+	}
+}
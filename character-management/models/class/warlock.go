@@ -3,6 +3,8 @@ package class
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/onioncall/dndgo/character-management/models"
 	"github.com/onioncall/dndgo/character-management/shared"
@@ -18,9 +20,56 @@ const (
 	InvocationImprovedPactWeapon   = "improved pact weapon"
 )
 
+const (
+	PactBoonBlade = "pact of the blade"
+	PactBoonChain = "pact of the chain"
+	PactBoonTome  = "pact of the tome"
+)
+
+var pactBoons = []string{
+	PactBoonBlade,
+	PactBoonChain,
+	PactBoonTome,
+}
+
+// Warlocks choose their pact boon at 3rd level
+const pactBoonLevel = 3
+
+// Invocations known by warlock level, indexed by warlock level - 1
+var invocationsKnown = [shared.MaxLevel]int{0, 2, 2, 2, 3, 3, 4, 4, 5, 5, 5, 6, 6, 6, 7, 7, 7, 8, 8, 8}
+
+type invocationPrerequisite struct {
+	level    int
+	pactBoon string
+	spell    string
+}
+
+var invocationPrerequisites = map[string]invocationPrerequisite{
+	InvocationAgonizingBlast:       {spell: "eldritch blast"},
+	InvocationGiftOfEverLivingOnes: {pactBoon: PactBoonChain},
+	InvocationLifedrinker:          {level: 12, pactBoon: PactBoonBlade},
+	InvocationImprovedPactWeapon:   {pactBoon: PactBoonBlade},
+}
+
 type Warlock struct {
 	models.BaseClass
-	Invocations []string `json:"invocations" clover:"invocations"`
+	Invocations        []string            `json:"invocations" clover:"invocations"`
+	InvocationFeatures []InvocationFeature `json:"-" clover:"-"`
+	PactBoon           string              `json:"pact-boon" clover:"pact-boon"`
+	PactSlots          shared.SpellSlot    `json:"pact-slots" clover:"pact-slots"`
+	SpellSwap          bool                `json:"spell-swap" clover:"spell-swap"`
+	SpellsKnownCount   int                 `json:"-" clover:"-"`
+	CantripsKnownCount int                 `json:"-" clover:"-"`
+}
+
+// Spells and cantrips known by warlock level, indexed by warlock level - 1
+var warlockSpellsKnown = [shared.MaxLevel]int{2, 3, 4, 5, 6, 7, 8, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15}
+var warlockCantripsKnown = [shared.MaxLevel]int{2, 2, 2, 3, 3, 3, 3, 3, 3, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4}
+
+type InvocationFeature struct {
+	Name      string `json:"name" clover:"name"`
+	IsApplied bool   `json:"is-applied" clover:"is-applied"`
+	Details   string `json:"details" clover:"details"`
 }
 
 func LoadWarlock(data []byte) (*Warlock, error) {
@@ -34,31 +83,113 @@ func LoadWarlock(data []byte) (*Warlock, error) {
 
 func (w *Warlock) ExecutePostCalculateMethods(c *models.Character) {
 	w.executeSpellCastingAbility(c)
+	w.executePactMagic()
 	w.executeEldritchInvocations(c)
+	w.executeKnownSpells(c)
 }
 
 func (w *Warlock) CalculateHitDice() string {
 	return fmt.Sprintf("%dd8", w.Level)
 }
 
+func (w *Warlock) executeKnownSpells(c *models.Character) {
+	w.SpellsKnownCount, w.CantripsKnownCount = executeKnownSpellsShared(c, w.ClassType, w.GetSpellsKnown(), w.GetCantripsKnown())
+}
+
 func (w *Warlock) executeSpellCastingAbility(c *models.Character) {
-	chrMod := c.GetMod(shared.AbilityCharisma)
+	c.SetSpellcasting(w.ClassType, shared.AbilityCharisma)
+}
+
+// Pact slots gained from a new warlock level are available right away
+func (w *Warlock) executePactMagic() {
+	if w.Level < 1 {
+		return
+	}
 
-	executeSpellSaveDC(c, chrMod)
-	executeSpellAttackMod(c, chrMod)
+	level := min(w.Level, shared.MaxLevel)
+	maximum := shared.PactMagicSlots[level-1]
+
+	w.PactSlots.Available = min(max(w.PactSlots.Available+maximum-w.PactSlots.Maximum, 0), maximum)
+	w.PactSlots.Maximum = maximum
+	w.PactSlots.Level = shared.PactMagicSlotLevel[level-1]
 }
 
-// May implement more thoroughly in the future, but most of these involve game state that we can't mock
-// in this app. Will look into in the future when I know more about how this class plays
+// Invocations past the number known for the warlock's level, or without their prerequisites, are listed but
+// not applied
 func (w *Warlock) executeEldritchInvocations(c *models.Character) {
-	// if c.Level > 2 {
-	// 	return
-	// }
-	//
-	// switch w.Invocation {
-	// case InvocationArmorOfShadows:
-	// 	applyArmorOfShadows(c)
-	// }
+	w.InvocationFeatures = []InvocationFeature{}
+	known := w.GetInvocationsKnown()
+
+	for i, invocation := range w.Invocations {
+		feature := InvocationFeature{Name: invocation}
+
+		if i >= known {
+			feature.Details = fmt.Sprintf("Only %d invocations are known at warlock level %d", known, w.Level)
+		} else if err := w.checkInvocationPrerequisite(invocation, c); err != nil {
+			feature.Details = err.Error()
+		} else {
+			feature.Details, feature.IsApplied = applyInvocation(invocation, c)
+		}
+
+		w.InvocationFeatures = append(w.InvocationFeatures, feature)
+	}
+}
+
+func (w *Warlock) GetInvocationsKnown() int {
+	if w.Level < 1 {
+		return 0
+	}
+
+	return invocationsKnown[min(w.Level, shared.MaxLevel)-1]
+}
+
+// The spell prerequisite needs the character, a nil character skips it so invocations can be checked while
+// they are being added
+func (w *Warlock) checkInvocationPrerequisite(invocation string, c *models.Character) error {
+	prerequisite, ok := invocationPrerequisites[strings.ToLower(invocation)]
+	if !ok {
+		return nil
+	}
+
+	if w.Level < prerequisite.level {
+		return fmt.Errorf("Requires warlock level %d", prerequisite.level)
+	}
+
+	if prerequisite.pactBoon != "" && (w.Level < pactBoonLevel || !strings.EqualFold(w.PactBoon, prerequisite.pactBoon)) {
+		return fmt.Errorf("Requires the %s", prerequisite.pactBoon)
+	}
+
+	if prerequisite.spell != "" && c != nil && !c.KnowsSpell(prerequisite.spell) {
+		return fmt.Errorf("Requires knowing the %s cantrip", prerequisite.spell)
+	}
+
+	return nil
+}
+
+// Returns the invocation's effect on the character, and whether it could be applied
+func applyInvocation(invocation string, c *models.Character) (string, bool) {
+	chrMod := c.GetMod(shared.AbilityCharisma)
+
+	switch strings.ToLower(invocation) {
+	case InvocationArmorOfShadows:
+		if !applyArmorOfShadows(c) {
+			return "Mage armor at will, not applied while wearing armor or when your AC is already higher", false
+		}
+
+		return fmt.Sprintf("Mage armor at will, your AC is %d while not wearing armor", c.AC), true
+	case InvocationAgonizingBlast:
+		return fmt.Sprintf("Add %+d (charisma) to the damage of each Eldritch Blast beam", chrMod), true
+	case InvocationFiendishVigor:
+		return "Cast false life on yourself at will as a 1st level spell, without using a spell slot", true
+	case InvocationGiftOfEverLivingOnes:
+		return "While your familiar is within 100 feet, dice rolled to regain hit points count as their maximum", true
+	case InvocationLifedrinker:
+		return fmt.Sprintf("Pact weapon hits deal an extra %d necrotic damage (charisma, minimum 1)", max(chrMod, 1)), true
+	case InvocationImprovedPactWeapon:
+		return "+1 to attack and damage rolls with a pact weapon that isn't already magical, and it can be used as a spellcasting focus", true
+	}
+
+	return "Effect isn't tracked, see the invocation's description", true
 }
 
 func applyArmorOfShadows(c *models.Character) bool {
@@ -67,7 +198,7 @@ func applyArmorOfShadows(c *models.Character) bool {
 	}
 
 	dexMod := c.GetMod(shared.AbilityDexterity)
-	armorOfShadows := 13 + dexMod
+	armorOfShadows := 13 + dexMod + c.GetShieldACBonus() + c.GetMagicItemACBonus()
 
 	if !c.ValidationDisabled {
 		if c.AC > armorOfShadows {
@@ -85,13 +216,134 @@ func (w *Warlock) ClassDetails() string {
 	var s string
 
 	s += fmt.Sprintf("Level: %d\n", w.Level)
+	s += formatKnownSpells(w.SpellsKnownCount, w.GetSpellsKnown(), w.CantripsKnownCount, w.GetCantripsKnown(), w.SpellSwap)
+
+	if w.PactSlots.Maximum > 0 {
+		s += fmt.Sprintf("*Pact Magic*: %d/%d level %d slots\n\n", w.PactSlots.Available, w.PactSlots.Maximum, w.PactSlots.Level)
+	}
+
+	if w.PactBoon != "" && w.Level >= pactBoonLevel {
+		s += fmt.Sprintf("*Pact Boon*: %s\n\n", w.PactBoon)
+	}
 
-	if len(w.Invocations) > 0 && w.Level > 3 {
-		s += "Invocation:\n\n"
-		for _, invocation := range w.Invocations {
-			s += fmt.Sprintf("%s\n", invocation)
+	if len(w.InvocationFeatures) > 0 {
+		s += fmt.Sprintf("**Eldritch Invocations** (%d known at this level):\n", w.GetInvocationsKnown())
+		for _, feature := range w.InvocationFeatures {
+			inactive := ""
+			if !feature.IsApplied {
+				inactive = " (inactive)"
+			}
+
+			s += fmt.Sprintf("*%s*%s\n%s\n\n", feature.Name, inactive, feature.Details)
 		}
 	}
 
 	return s
 }
+
+// CLI
+
+// Pact Magic slots are all the same level, a level of 0 uses one regardless of its level
+func (w *Warlock) UseSpellSlot(level int) error {
+	if w.PactSlots.Maximum == 0 {
+		return fmt.Errorf("No pact magic slots for class '%s'", w.ClassType)
+	}
+
+	if level != 0 && level != w.PactSlots.Level {
+		return fmt.Errorf("Pact magic slots are level %d, not level %d", w.PactSlots.Level, level)
+	}
+
+	if w.PactSlots.Available <= 0 {
+		return fmt.Errorf("No pact magic slots available")
+	}
+
+	w.PactSlots.Available--
+	return nil
+}
+
+func (w *Warlock) RecoverSpellSlots(level int, quantity int) {
+	if level != 0 && level != w.PactSlots.Level {
+		return
+	}
+
+	w.PactSlots.Available += quantity
+
+	// if no quantity is provided, or the new value exceeds the max we will perform a full recover
+	if quantity == 0 || w.PactSlots.Available > w.PactSlots.Maximum {
+		w.PactSlots.Available = w.PactSlots.Maximum
+	}
+}
+
+func (w *Warlock) GetSpellSlots() []shared.SpellSlot {
+	if w.PactSlots.Maximum == 0 {
+		return nil
+	}
+
+	return []shared.SpellSlot{w.PactSlots}
+}
+
+// Pact Magic slots come back on a short rest
+func (w *Warlock) RecoverShortRestTokens() {
+	w.PactSlots.Available = w.PactSlots.Maximum
+}
+
+func (w *Warlock) AddInvocation(invocation string) error {
+	for _, inv := range w.Invocations {
+		if strings.EqualFold(inv, invocation) {
+			return fmt.Errorf("Invocation '%s' already exists in list of invocations", invocation)
+		}
+	}
+
+	if len(w.Invocations) >= w.GetInvocationsKnown() {
+		return fmt.Errorf("Warlock level %d only knows %d invocations", w.Level, w.GetInvocationsKnown())
+	}
+
+	if err := w.checkInvocationPrerequisite(invocation, nil); err != nil {
+		return fmt.Errorf("Prerequisite for invocation '%s' not met:\n%w", invocation, err)
+	}
+
+	w.Invocations = append(w.Invocations, invocation)
+	return nil
+}
+
+func (w *Warlock) RemoveInvocation(invocation string) error {
+	for i, inv := range w.Invocations {
+		if strings.EqualFold(inv, invocation) {
+			w.Invocations = slices.Delete(w.Invocations, i, i+1)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Invocation '%s' not found in list of invocations", invocation)
+}
+
+func (w *Warlock) SetPactBoon(pactBoon string) error {
+	if w.Level < pactBoonLevel {
+		return fmt.Errorf("Pact boons are chosen at warlock level %d", pactBoonLevel)
+	}
+
+	for _, pb := range pactBoons {
+		if strings.EqualFold(pb, pactBoon) {
+			w.PactBoon = pb
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s not one of the valid pact boons, %s", pactBoon, strings.Join(pactBoons, ", "))
+}
+
+func (w *Warlock) GetSpellsKnown() int {
+	return getKnownByLevel(warlockSpellsKnown, w.Level)
+}
+
+func (w *Warlock) GetCantripsKnown() int {
+	return getKnownByLevel(warlockCantripsKnown, w.Level)
+}
+
+func (w *Warlock) GetSpellSwap() bool {
+	return w.SpellSwap
+}
+
+func (w *Warlock) SetSpellSwap(available bool) {
+	w.SpellSwap = available
+}
@@ -1,6 +1,7 @@
 package class
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/onioncall/dndgo/character-management/models"
@@ -43,6 +44,22 @@ func TestWarlockAppliedArmorOfShadows(t *testing.T) {
 			expected: 17,
 			applied:  true,
 		},
+		{
+			name: "Armor not equiped, shield equiped, bonus added with shield",
+			character: &models.Character{
+				AC: 13,
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityDexterity, AbilityModifier: 1},
+				},
+				WornEquipment: shared.WornEquipment{
+					Shield: "Shield",
+				},
+				PrimaryEquipped:   "Dagger",
+				SecondaryEquipped: "Shield",
+			},
+			expected: 16,
+			applied:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -60,3 +77,244 @@ func TestWarlockAppliedArmorOfShadows(t *testing.T) {
 		})
 	}
 }
+
+func TestWarlockExecutePactMagic(t *testing.T) {
+	tests := []struct {
+		name     string
+		warlock  *Warlock
+		expected shared.SpellSlot
+	}{
+		{
+			name: "New warlock starts with full slots",
+			warlock: &Warlock{
+				BaseClass: models.BaseClass{Level: 1},
+			},
+			expected: shared.SpellSlot{Level: 1, Maximum: 1, Available: 1},
+		},
+		{
+			name: "New level adds a slot and raises the slot level",
+			warlock: &Warlock{
+				BaseClass: models.BaseClass{Level: 11},
+				PactSlots: shared.SpellSlot{Level: 5, Maximum: 2, Available: 0},
+			},
+			expected: shared.SpellSlot{Level: 5, Maximum: 3, Available: 1},
+		},
+		{
+			name: "Spent slots stay spent",
+			warlock: &Warlock{
+				BaseClass: models.BaseClass{Level: 5},
+				PactSlots: shared.SpellSlot{Level: 3, Maximum: 2, Available: 1},
+			},
+			expected: shared.SpellSlot{Level: 3, Maximum: 2, Available: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.warlock.executePactMagic()
+
+			if tt.expected != tt.warlock.PactSlots {
+				t.Errorf("PactSlots- Expected: %+v, Result: %+v", tt.expected, tt.warlock.PactSlots)
+			}
+		})
+	}
+}
+
+func TestWarlockUseSpellSlot(t *testing.T) {
+	tests := []struct {
+		name      string
+		warlock   *Warlock
+		level     int
+		expected  int
+		expectErr bool
+	}{
+		{
+			name: "Any level",
+			warlock: &Warlock{
+				PactSlots: shared.SpellSlot{Level: 3, Maximum: 2, Available: 2},
+			},
+			level:    0,
+			expected: 1,
+		},
+		{
+			name: "Matching level",
+			warlock: &Warlock{
+				PactSlots: shared.SpellSlot{Level: 3, Maximum: 2, Available: 1},
+			},
+			level:    3,
+			expected: 0,
+		},
+		{
+			name: "Wrong level",
+			warlock: &Warlock{
+				PactSlots: shared.SpellSlot{Level: 3, Maximum: 2, Available: 2},
+			},
+			level:     2,
+			expected:  2,
+			expectErr: true,
+		},
+		{
+			name: "No slots left",
+			warlock: &Warlock{
+				PactSlots: shared.SpellSlot{Level: 3, Maximum: 2, Available: 0},
+			},
+			level:     0,
+			expected:  0,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.warlock.UseSpellSlot(tt.level)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error using a level %d slot", tt.level)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if tt.expected != tt.warlock.PactSlots.Available {
+				t.Errorf("Available- Expected: %d, Result: %d", tt.expected, tt.warlock.PactSlots.Available)
+			}
+		})
+	}
+}
+
+func TestWarlockExecuteEldritchInvocations(t *testing.T) {
+	tests := []struct {
+		name      string
+		warlock   *Warlock
+		character *models.Character
+		expected  []bool
+	}{
+		{
+			name: "Agonizing blast requires eldritch blast",
+			warlock: &Warlock{
+				BaseClass:   models.BaseClass{Level: 2},
+				Invocations: []string{"Agonizing Blast", "Fiendish Vigor"},
+			},
+			character: &models.Character{},
+			expected:  []bool{false, true},
+		},
+		{
+			name: "Agonizing blast with eldritch blast known",
+			warlock: &Warlock{
+				BaseClass:   models.BaseClass{Level: 2},
+				Invocations: []string{"Agonizing Blast"},
+			},
+			character: &models.Character{
+				Spells: []shared.CharacterSpell{{Name: "Eldritch Blast"}},
+			},
+			expected: []bool{true},
+		},
+		{
+			name: "Pact boon and level prerequisites",
+			warlock: &Warlock{
+				BaseClass:   models.BaseClass{Level: 11},
+				PactBoon:    PactBoonBlade,
+				Invocations: []string{"Improved Pact Weapon", "Lifedrinker", "Gift of the Ever-Living Ones"},
+			},
+			character: &models.Character{},
+			expected:  []bool{true, false, false},
+		},
+		{
+			name: "Invocations past the number known aren't applied",
+			warlock: &Warlock{
+				BaseClass:   models.BaseClass{Level: 2},
+				Invocations: []string{"Fiendish Vigor", "Devil's Sight", "Mask of Many Faces"},
+			},
+			character: &models.Character{},
+			expected:  []bool{true, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.warlock.executeEldritchInvocations(tt.character)
+
+			result := []bool{}
+			for _, feature := range tt.warlock.InvocationFeatures {
+				result = append(result, feature.IsApplied)
+			}
+
+			if !slices.Equal(tt.expected, result) {
+				t.Errorf("Applied- Expected: %v, Result: %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestWarlockAddInvocation(t *testing.T) {
+	tests := []struct {
+		name       string
+		warlock    *Warlock
+		invocation string
+		expected   int
+		expectErr  bool
+	}{
+		{
+			name: "Invocation added",
+			warlock: &Warlock{
+				BaseClass: models.BaseClass{Level: 2},
+			},
+			invocation: InvocationArmorOfShadows,
+			expected:   1,
+		},
+		{
+			name: "Already known",
+			warlock: &Warlock{
+				BaseClass:   models.BaseClass{Level: 2},
+				Invocations: []string{"Armor of Shadows"},
+			},
+			invocation: InvocationArmorOfShadows,
+			expected:   1,
+			expectErr:  true,
+		},
+		{
+			name: "No invocations known at level 1",
+			warlock: &Warlock{
+				BaseClass: models.BaseClass{Level: 1},
+			},
+			invocation: InvocationFiendishVigor,
+			expected:   0,
+			expectErr:  true,
+		},
+		{
+			name: "All invocations known",
+			warlock: &Warlock{
+				BaseClass:   models.BaseClass{Level: 5},
+				Invocations: []string{"Armor of Shadows", "Fiendish Vigor", "Devil's Sight"},
+			},
+			invocation: InvocationAgonizingBlast,
+			expected:   3,
+			expectErr:  true,
+		},
+		{
+			name: "Missing pact boon",
+			warlock: &Warlock{
+				BaseClass: models.BaseClass{Level: 5},
+				PactBoon:  PactBoonTome,
+			},
+			invocation: InvocationImprovedPactWeapon,
+			expected:   0,
+			expectErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.warlock.AddInvocation(tt.invocation)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error adding invocation '%s'", tt.invocation)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if tt.expected != len(tt.warlock.Invocations) {
+				t.Errorf("Invocations- Expected: %d, Result: %d", tt.expected, len(tt.warlock.Invocations))
+			}
+		})
+	}
+}
@@ -13,10 +13,18 @@ import (
 
 type Wizard struct {
 	models.BaseClass
-	SignatureSpells []string `json:"signature-spells" clover:"signature-spells"`
-	PreparedSpells  []string `json:"prepared-spells" clover:"prepared-spells"`
+	Spellbook         []string            `json:"spellbook" clover:"spellbook"`
+	SignatureSpells   []string            `json:"signature-spells" clover:"signature-spells"`
+	PreparedSpells    []string            `json:"prepared-spells" clover:"prepared-spells"`
+	PreparedSpellsMax int                 `json:"-" clover:"-"`
+	ClassTokens       []shared.NamedToken `json:"class-tokens" clover:"class-tokens"`
 }
 
+const (
+	arcaneRecoveryToken  = "arcane-recovery"
+	signatureSpellPrefix = "signature-"
+)
+
 func LoadWizard(data []byte) (*Wizard, error) {
 	var wizard Wizard
 	if err := json.Unmarshal(data, &wizard); err != nil {
@@ -28,23 +36,81 @@ func LoadWizard(data []byte) (*Wizard, error) {
 
 func (w *Wizard) ExecutePostCalculateMethods(c *models.Character) {
 	w.executeSpellCastingAbility(c)
+	w.executeSpellbook()
+	w.executePreparedSpellsMax(c)
 	w.executePreparedSpells(c)
 	w.executeSignatureSpellValidation(c)
+	w.executeClassTokens()
 }
 
 func (w *Wizard) CalculateHitDice() string {
 	return fmt.Sprintf("%dd6", w.Level)
 }
 
-func (w *Wizard) executePreparedSpells(c *models.Character) {
-	executePreparedSpellsShared(c, w.PreparedSpells)
+// Spells prepared before the spellbook was tracked are copied into it, since a wizard can only prepare from their book
+func (w *Wizard) executeSpellbook() {
+	for _, spell := range w.PreparedSpells {
+		if !slices.ContainsFunc(w.Spellbook, func(s string) bool { return strings.EqualFold(s, spell) }) {
+			w.Spellbook = append(w.Spellbook, spell)
+		}
+	}
 }
 
-func (w *Wizard) executeSpellCastingAbility(c *models.Character) {
+func (w *Wizard) executePreparedSpellsMax(c *models.Character) {
 	intMod := c.GetMod(shared.AbilityIntelligence)
+	w.PreparedSpellsMax = max(intMod+w.Level, 1)
+}
+
+func (w *Wizard) executePreparedSpells(c *models.Character) {
+	validatePreparedSpellsShared(c, w.PreparedSpells, w.PreparedSpellsMax)
+	preparedSpells := w.PreparedSpells
+
+	// Signature spells are always prepared and don't count against the number of prepared spells
+	if w.Level >= 20 {
+		preparedSpells = append(slices.Clone(preparedSpells), w.SignatureSpells...)
+	}
+
+	executePreparedSpellsShared(c, preparedSpells)
+}
+
+// Arcane Recovery is once per day, and each signature spell can be cast once per rest without a slot
+func (w *Wizard) executeClassTokens() {
+	tokens := []shared.NamedToken{w.newToken(arcaneRecoveryToken, 1, shared.TokenRecoveryLongRest)}
+
+	if w.Level >= 20 {
+		for _, spell := range w.SignatureSpells {
+			tokens = append(tokens, w.newToken(signatureSpellToken(spell), 20, shared.TokenRecoveryShortRest))
+		}
+	}
+
+	w.ClassTokens = tokens
+}
+
+// Keeps the available uses of a token that's already been tracked, new tokens start full
+func (w *Wizard) newToken(name string, level int, recoveryType string) shared.NamedToken {
+	token := shared.NamedToken{
+		Name:      name,
+		Available: 1,
+	}
+
+	if existing := getToken(name, w.ClassTokens); existing != nil {
+		token.Available = existing.Available
+	}
+
+	token.Maximum = 1
+	token.Level = level
+	token.RecoveryType = recoveryType
+	token.Available = min(max(token.Available, 0), token.Maximum)
+
+	return token
+}
 
-	executeSpellSaveDC(c, intMod)
-	executeSpellAttackMod(c, intMod)
+func signatureSpellToken(spell string) string {
+	return signatureSpellPrefix + strings.ReplaceAll(strings.ToLower(spell), " ", "-")
+}
+
+func (w *Wizard) executeSpellCastingAbility(c *models.Character) {
+	c.SetSpellcasting(w.ClassType, shared.AbilityIntelligence)
 }
 
 func (w *Wizard) executeSignatureSpellValidation(c *models.Character) {
@@ -76,11 +142,32 @@ func (w *Wizard) ClassDetails() string {
 	var s string
 
 	s += fmt.Sprintf("Level: %d\n", w.Level)
+	s += fmt.Sprintf("Prepared Spells: %d/%d\n", len(w.PreparedSpells), w.PreparedSpellsMax)
+
+	for _, token := range w.ClassTokens {
+		if token.Name == arcaneRecoveryToken {
+			slots := models.GetSlots(token.Available, token.Maximum)
+			s += fmt.Sprintf("Arcane Recovery: %s (up to %d slot levels)\n", slots, w.GetSlotRecoveryLevels())
+		}
+	}
+
+	if len(w.Spellbook) > 0 {
+		s += fmt.Sprintf("Spellbook:\n")
+		for _, spell := range w.Spellbook {
+			s += fmt.Sprintf("- %s\n", spell)
+		}
+	}
 
 	if w.Level >= 20 {
 		s += fmt.Sprintf("Signature Spells:\n")
 		for _, spell := range w.SignatureSpells {
-			s += fmt.Sprintf("- %s\n", spell)
+			token := getToken(signatureSpellToken(spell), w.ClassTokens)
+			if token == nil {
+				s += fmt.Sprintf("- %s\n", spell)
+				continue
+			}
+
+			s += fmt.Sprintf("- %s: %s\n", spell, models.GetSlots(token.Available, token.Maximum))
 		}
 	}
 
@@ -90,10 +177,14 @@ func (w *Wizard) ClassDetails() string {
 func (w *Wizard) AddPreparedSpell(spell string) error {
 	for _, ps := range w.PreparedSpells {
 		if strings.EqualFold(ps, spell) {
-			return fmt.Errorf("Spell 's' already exists as a prepared spell")
+			return fmt.Errorf("Spell '%s' already exists as a prepared spell", spell)
 		}
 	}
 
+	if !slices.ContainsFunc(w.Spellbook, func(s string) bool { return strings.EqualFold(s, spell) }) {
+		return fmt.Errorf("Spell '%s' is not in your spellbook", spell)
+	}
+
 	w.PreparedSpells = append(w.PreparedSpells, spell)
 
 	return nil
@@ -113,3 +204,105 @@ func (w *Wizard) RemovePreparedSpell(spell string) error {
 func (w *Wizard) GetPreparedSpells() []string {
 	return w.PreparedSpells
 }
+
+func (w *Wizard) GetPreparedSpellsMax() int {
+	return w.PreparedSpellsMax
+}
+
+func (w *Wizard) AddSpellbookSpell(spell string) error {
+	for _, bs := range w.Spellbook {
+		if strings.EqualFold(bs, spell) {
+			return fmt.Errorf("Spell '%s' is already in your spellbook", spell)
+		}
+	}
+
+	w.Spellbook = append(w.Spellbook, spell)
+
+	return nil
+}
+
+// Removing a spell from the spellbook also unprepares it
+func (w *Wizard) RemoveSpellbookSpell(spell string) error {
+	for i, bs := range w.Spellbook {
+		if strings.EqualFold(bs, spell) {
+			w.Spellbook = slices.Delete(w.Spellbook, i, i+1)
+			w.PreparedSpells = slices.DeleteFunc(w.PreparedSpells, func(s string) bool { return strings.EqualFold(s, spell) })
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Failed to find spell '%s' in spellbook to remove", spell)
+}
+
+func (w *Wizard) GetSpellbook() []string {
+	return w.Spellbook
+}
+
+// Arcane Recovery restores slots with a combined level of up to half the wizard level, rounded up
+func (w *Wizard) GetSlotRecoveryLevels() int {
+	return (w.Level + 1) / 2
+}
+
+func (w *Wizard) UseSlotRecovery() error {
+	token := getToken(arcaneRecoveryToken, w.ClassTokens)
+	if token == nil || token.Available <= 0 {
+		return fmt.Errorf("Arcane Recovery has already been used today")
+	}
+
+	token.Available--
+
+	return nil
+}
+
+func (w *Wizard) UseClassTokens(tokenName string, quantity int) {
+	token := getToken(tokenName, w.ClassTokens)
+
+	if token == nil {
+		logger.Info(fmt.Sprintf("Invalid token name: %s", tokenName))
+		return
+	}
+
+	if token.Available <= 0 {
+		logger.Info(fmt.Sprintf("%s had no uses left", tokenName))
+		return
+	}
+
+	token.Available = max(token.Available-quantity, 0)
+}
+
+func (w *Wizard) RecoverClassTokens(tokenName string, quantity int) {
+	if tokenName == "" {
+		fullTokenRecovery(w.ClassTokens)
+		return
+	}
+
+	token := getToken(tokenName, w.ClassTokens)
+
+	if token == nil {
+		logger.Info(fmt.Sprintf("Invalid token name: %s", tokenName))
+		return
+	}
+
+	if quantity == 0 || token.Available+quantity > token.Maximum {
+		token.Available = token.Maximum
+		return
+	}
+
+	token.Available += quantity
+}
+
+func (w *Wizard) RecoverShortRestTokens() {
+	for i := range w.ClassTokens {
+		recoverShortRestToken(&w.ClassTokens[i])
+	}
+}
+
+func (w *Wizard) GetTokens() []string {
+	s := []string{}
+
+	for _, token := range w.ClassTokens {
+		s = append(s, token.Name)
+	}
+
+	return s
+}
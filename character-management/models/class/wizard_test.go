@@ -1,6 +1,7 @@
 package class
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/onioncall/dndgo/character-management/models"
@@ -110,3 +111,149 @@ func TestWizardExecutePreparedSpells(t *testing.T) {
 		})
 	}
 }
+
+func TestWizardAddPreparedSpell(t *testing.T) {
+	tests := []struct {
+		name      string
+		wizard    Wizard
+		spell     string
+		expected  []string
+		expectErr bool
+	}{
+		{
+			name: "Prepare a spell from the spellbook",
+			wizard: Wizard{
+				Spellbook:         []string{"Shield", "Magic Missile"},
+				PreparedSpells:    []string{"Shield"},
+				PreparedSpellsMax: 2,
+			},
+			spell:    "magic missile",
+			expected: []string{"Shield", "magic missile"},
+		},
+		{
+			name: "Spell not in the spellbook",
+			wizard: Wizard{
+				Spellbook:         []string{"Shield"},
+				PreparedSpellsMax: 2,
+			},
+			spell:     "Fireball",
+			expected:  nil,
+			expectErr: true,
+		},
+		{
+			name: "Spell already prepared",
+			wizard: Wizard{
+				Spellbook:         []string{"Shield"},
+				PreparedSpells:    []string{"Shield"},
+				PreparedSpellsMax: 3,
+			},
+			spell:     "shield",
+			expected:  []string{"Shield"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.wizard.AddPreparedSpell(tt.spell)
+
+			if tt.expectErr && err == nil {
+				t.Errorf("Error- Expected an error preparing '%s'", tt.spell)
+			} else if !tt.expectErr && err != nil {
+				t.Errorf("Error- Unexpected error: %v", err)
+			}
+
+			if !slices.Equal(tt.expected, tt.wizard.PreparedSpells) {
+				t.Errorf("Prepared Spells- Expected: %v, Result: %v", tt.expected, tt.wizard.PreparedSpells)
+			}
+		})
+	}
+}
+
+func TestWizardExecutePreparedSpellsMax(t *testing.T) {
+	tests := []struct {
+		name     string
+		intMod   int
+		level    int
+		expected int
+	}{
+		{
+			name:     "Intelligence mod plus wizard level",
+			intMod:   3,
+			level:    4,
+			expected: 7,
+		},
+		{
+			name:     "Minimum of one",
+			intMod:   -2,
+			level:    1,
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{
+				Abilities: []shared.Ability{
+					{Name: shared.AbilityIntelligence, AbilityModifier: tt.intMod},
+				},
+			}
+			wizard := &Wizard{BaseClass: models.BaseClass{Level: tt.level}}
+			wizard.executePreparedSpellsMax(character)
+
+			if tt.expected != wizard.PreparedSpellsMax {
+				t.Errorf("Prepared Spells Max- Expected: %d, Result: %d", tt.expected, wizard.PreparedSpellsMax)
+			}
+		})
+	}
+}
+
+func TestWizardExecuteClassTokens(t *testing.T) {
+	tests := []struct {
+		name     string
+		wizard   Wizard
+		expected []shared.NamedToken
+	}{
+		{
+			name:   "Arcane recovery starts available",
+			wizard: Wizard{BaseClass: models.BaseClass{Level: 3}},
+			expected: []shared.NamedToken{
+				{Name: "arcane-recovery", Maximum: 1, Available: 1, Level: 1, RecoveryType: shared.TokenRecoveryLongRest},
+			},
+		},
+		{
+			name: "Used arcane recovery stays used, signature spells below level 20 have no tokens",
+			wizard: Wizard{
+				BaseClass:       models.BaseClass{Level: 19},
+				SignatureSpells: []string{"Fireball"},
+				ClassTokens:     []shared.NamedToken{{Name: "arcane-recovery", Available: 0}},
+			},
+			expected: []shared.NamedToken{
+				{Name: "arcane-recovery", Maximum: 1, Available: 0, Level: 1, RecoveryType: shared.TokenRecoveryLongRest},
+			},
+		},
+		{
+			name: "Signature spells at level 20",
+			wizard: Wizard{
+				BaseClass:       models.BaseClass{Level: 20},
+				SignatureSpells: []string{"Fireball", "Lightning Bolt"},
+				ClassTokens:     []shared.NamedToken{{Name: "signature-fireball", Available: 0}},
+			},
+			expected: []shared.NamedToken{
+				{Name: "arcane-recovery", Maximum: 1, Available: 1, Level: 1, RecoveryType: shared.TokenRecoveryLongRest},
+				{Name: "signature-fireball", Maximum: 1, Available: 0, Level: 20, RecoveryType: shared.TokenRecoveryShortRest},
+				{Name: "signature-lightning-bolt", Maximum: 1, Available: 1, Level: 20, RecoveryType: shared.TokenRecoveryShortRest},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wizard.executeClassTokens()
+
+			if !slices.Equal(tt.expected, tt.wizard.ClassTokens) {
+				t.Errorf("Class Tokens- Expected: %+v, Result: %+v", tt.expected, tt.wizard.ClassTokens)
+			}
+		})
+	}
+}
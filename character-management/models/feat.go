@@ -0,0 +1,164 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/onioncall/dndgo/character-management/shared"
+)
+
+// Feats with a known name apply their effects to the characters stats, any other feat is kept as
+// free text for homebrew. Ability is the one that gets +1 from feats like Resilient
+type Feat struct {
+	Name    string `json:"name"`
+	Desc    string `json:"description"`
+	Ability string `json:"ability,omitempty"`
+}
+
+// Feats added directly and feats taken in place of an ability score improvement
+func (c *Character) GetFeats() []Feat {
+	feats := []Feat{}
+	for _, feat := range c.Feats {
+		if feat.Name != "" {
+			feats = append(feats, feat)
+		}
+	}
+
+	for _, item := range c.AbilityScoreImprovement {
+		if item.Feat == "" {
+			continue
+		}
+
+		feats = append(feats, Feat{
+			Name:    item.Feat,
+			Desc:    shared.Feats[strings.ToLower(item.Feat)].Details,
+			Ability: item.Ability,
+		})
+	}
+
+	return feats
+}
+
+func (c *Character) HasFeat(name string) bool {
+	return slices.ContainsFunc(c.GetFeats(), func(f Feat) bool { return strings.EqualFold(f.Name, name) })
+}
+
+// Adds up one effect across every known feat the character has
+func (c *Character) getFeatBonus(effect func(shared.FeatDefinition) int) int {
+	bonus := 0
+	for _, feat := range c.GetFeats() {
+		if def, ok := shared.Feats[strings.ToLower(feat.Name)]; ok {
+			bonus += effect(def)
+		}
+	}
+
+	return bonus
+}
+
+// Feats taken through an ability score improvement already have their +1 in the improvement item,
+// so only feats added directly have their ability increased here
+func (c *Character) calculateFeats() {
+	for _, feat := range c.Feats {
+		def, ok := shared.Feats[strings.ToLower(feat.Name)]
+		if !ok || len(def.Abilities) == 0 || feat.Ability == "" {
+			continue
+		}
+
+		for i := range c.Abilities {
+			if strings.EqualFold(c.Abilities[i].Name, feat.Ability) {
+				c.Abilities[i].Adjusted = min(20, c.Abilities[i].Adjusted+1)
+			}
+		}
+	}
+}
+
+// Feats like resilient grant proficiency in the chosen ability's saving throw. This is checked when
+// the save is rolled rather than set on the ability, so removing the feat also removes the proficiency
+func (c *Character) hasFeatSaveProficiency(ability string) bool {
+	for _, feat := range c.GetFeats() {
+		if def := shared.Feats[strings.ToLower(feat.Name)]; def.SaveProficiency && strings.EqualFold(feat.Ability, ability) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checks a known feat isn't taken twice, and that feats with an ability increase have a valid ability chosen
+func (c *Character) validateFeat(name string, ability string) error {
+	if c.HasFeat(name) {
+		return fmt.Errorf("%s already has the feat '%s'", c.Name, name)
+	}
+
+	def, ok := shared.Feats[strings.ToLower(name)]
+	if !ok || len(def.Abilities) == 0 {
+		return nil
+	}
+
+	if !slices.Contains(def.Abilities, strings.ToLower(ability)) {
+		return fmt.Errorf("Feat '%s' needs one of these abilities for its +1: %s", name, strings.Join(def.Abilities, ", "))
+	}
+
+	return nil
+}
+
+// Takes a feat in place of an ability score improvement. Feats with an ability increase need the ability
+// chosen, ex: 'resilient' with 'constitution'
+func (c *Character) ChooseFeat(name string, ability string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	ability = strings.ToLower(strings.TrimSpace(ability))
+
+	if err := c.validateFeat(name, ability); err != nil {
+		return err
+	}
+
+	item := shared.AbilityScoreImprovementItem{Feat: name}
+	if def, ok := shared.Feats[name]; ok && len(def.Abilities) > 0 {
+		item.Ability = ability
+		item.Bonus = 1
+	}
+
+	c.AbilityScoreImprovement = append(c.AbilityScoreImprovement, item)
+
+	return nil
+}
+
+// Feats gained outside of an ability score improvement, like the variant human's, or homebrew feats
+func (c *Character) AddFeat(name string, desc string, ability string) error {
+	if err := c.validateFeat(name, ability); err != nil {
+		return err
+	}
+
+	// Character templates start with a single empty feat
+	if len(c.Feats) == 1 && c.Feats[0].Name == "" {
+		c.Feats = []Feat{}
+	}
+
+	def, ok := shared.Feats[strings.ToLower(name)]
+	if desc == "" && ok {
+		desc = def.Details
+	}
+
+	if !ok || len(def.Abilities) == 0 {
+		ability = ""
+	}
+
+	c.Feats = append(c.Feats, Feat{Name: name, Desc: desc, Ability: strings.ToLower(ability)})
+
+	return nil
+}
+
+// Sharpshooter and Great Weapon Master are the players choice before each attack, so they're offered rather than applied
+func (c *Character) getAttackOptions(weapon shared.Weapon) []string {
+	options := []string{}
+	if weapon.Ranged && c.HasFeat(shared.FeatSharpshooter) {
+		options = append(options, "Sharpshooter, -5 to hit for +10 damage")
+	}
+
+	if !weapon.Ranged && slices.Contains(weapon.Properties, shared.WeaponPropertyHeavy) && c.HasFeat(shared.FeatGreatWeaponMaster) {
+		options = append(options, "Great Weapon Master, -5 to hit for +10 damage")
+	}
+
+	return options
+}
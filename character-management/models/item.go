@@ -0,0 +1,253 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/onioncall/dndgo/character-management/shared"
+)
+
+func (c *Character) wornItemSlots() []*shared.MagicItem {
+	return []*shared.MagicItem{
+		&c.WornEquipment.Head,
+		&c.WornEquipment.Amulet,
+		&c.WornEquipment.Cloak,
+		&c.WornEquipment.HandsArms,
+		&c.WornEquipment.Ring,
+		&c.WornEquipment.Ring2,
+		&c.WornEquipment.Belt,
+		&c.WornEquipment.Boots,
+	}
+}
+
+// Worn items whose modifiers apply, known magic items have their modifiers filled in.
+// Items that require attunement are left out until the character attunes to them
+func (c *Character) getWornItems() []shared.MagicItem {
+	items := []shared.MagicItem{}
+	for _, slot := range c.wornItemSlots() {
+		if slot.Name == "" {
+			continue
+		}
+
+		item := slot.Resolve()
+		if item.RequiresAttunement && !item.Attuned {
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func (c *Character) getMagicItemBonus(effect func(shared.MagicItem) int) int {
+	bonus := 0
+	for _, item := range c.getWornItems() {
+		bonus += effect(item)
+	}
+
+	return bonus
+}
+
+// Classes that replace the armor calculation (unarmored defense, armor of shadows) add this on top
+func (c *Character) GetMagicItemACBonus() int {
+	return c.getMagicItemBonus(func(m shared.MagicItem) int { return m.ACBonus })
+}
+
+// Items like a belt of giant strength set a score outright, this runs after ability score improvements
+// so the item only matters when it's higher than what the character already has
+func (c *Character) calculateMagicItems() {
+	for _, item := range c.getWornItems() {
+		for ability, score := range item.AbilityScores {
+			for i := range c.Abilities {
+				if strings.EqualFold(c.Abilities[i].Name, ability) {
+					c.Abilities[i].Adjusted = max(c.Abilities[i].Adjusted, score)
+				}
+			}
+		}
+	}
+}
+
+// Racial resistances along with any granted by worn items, without duplicates
+func (c *Character) GetResistances() []string {
+	resistances := slices.Clone(c.Resistances)
+	for _, item := range c.getWornItems() {
+		for _, r := range item.Resistances {
+			if !slices.ContainsFunc(resistances, func(res string) bool { return strings.EqualFold(res, r) }) {
+				resistances = append(resistances, r)
+			}
+		}
+	}
+
+	return resistances
+}
+
+// Item name followed by what it modifies, ex: 'ring of protection (+1 AC, +1 saving throws)'
+func FormatMagicItem(item shared.MagicItem) string {
+	item = item.Resolve()
+
+	modifiers := []string{}
+	if item.ACBonus != 0 {
+		modifiers = append(modifiers, fmt.Sprintf("%+d AC", item.ACBonus))
+	}
+	if item.SaveBonus != 0 {
+		modifiers = append(modifiers, fmt.Sprintf("%+d saving throws", item.SaveBonus))
+	}
+
+	abilities := make([]string, 0, len(item.AbilityScores))
+	for ability := range item.AbilityScores {
+		abilities = append(abilities, ability)
+	}
+	slices.Sort(abilities)
+	for _, ability := range abilities {
+		modifiers = append(modifiers, fmt.Sprintf("%s %d", ability, item.AbilityScores[ability]))
+	}
+
+	if item.Speed != 0 {
+		modifiers = append(modifiers, fmt.Sprintf("%+d speed", item.Speed))
+	}
+	if len(item.Resistances) > 0 {
+		modifiers = append(modifiers, fmt.Sprintf("resistance to %s", strings.Join(item.Resistances, ", ")))
+	}
+
+	switch {
+	case item.Attuned:
+		modifiers = append(modifiers, "attuned")
+	case item.RequiresAttunement:
+		modifiers = append(modifiers, "requires attunement")
+	}
+
+	if len(modifiers) == 0 {
+		return item.Name
+	}
+
+	return fmt.Sprintf("%s (%s)", item.Name, strings.Join(modifiers, ", "))
+}
+
+// Attunes to a worn item or one in the backpack. Only three items can be attuned at once, and
+// some can only be attuned by certain classes. Attuning to a homebrew item marks it as requiring
+// attunement, so its modifiers stop applying if the character unattunes
+func (c *Character) Attune(name string) error {
+	for _, slot := range c.wornItemSlots() {
+		if slot.Name == "" || !strings.EqualFold(slot.Name, name) {
+			continue
+		}
+
+		if err := c.validateAttunement(slot.Resolve()); err != nil {
+			return err
+		}
+
+		slot.Attuned = true
+		slot.RequiresAttunement = true
+
+		return nil
+	}
+
+	for i, item := range c.Backpack {
+		if item.Quantity <= 0 || !strings.EqualFold(item.Name, name) {
+			continue
+		}
+
+		magicItem := shared.MagicItem{Name: item.Name, Attuned: item.Attuned}
+		if err := c.validateAttunement(magicItem.Resolve()); err != nil {
+			return err
+		}
+
+		c.Backpack[i].Attuned = true
+
+		return nil
+	}
+
+	return fmt.Errorf("Item '%s' not found in worn equipment or backpack", name)
+}
+
+func (c *Character) Unattune(name string) error {
+	for _, slot := range c.wornItemSlots() {
+		if slot.Attuned && strings.EqualFold(slot.Name, name) {
+			slot.Attuned = false
+			return nil
+		}
+	}
+
+	for i, item := range c.Backpack {
+		if item.Attuned && strings.EqualFold(item.Name, name) {
+			c.Backpack[i].Attuned = false
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Character is not attuned to '%s'", name)
+}
+
+func (c *Character) validateAttunement(item shared.MagicItem) error {
+	if item.Attuned {
+		return fmt.Errorf("Character is already attuned to '%s'", item.Name)
+	}
+
+	if c.ValidationDisabled {
+		return nil
+	}
+
+	if attuned := c.GetAttunedItems(); len(attuned) >= shared.MaxAttunedItems {
+		return fmt.Errorf("Character is already attuned to %d items (%s), unattune one first",
+			shared.MaxAttunedItems, strings.Join(attuned, ", "))
+	}
+
+	if len(item.AttunementClasses) == 0 {
+		return nil
+	}
+
+	for _, class := range c.Classes {
+		if class != nil && slices.ContainsFunc(item.AttunementClasses, func(ac string) bool {
+			return strings.EqualFold(ac, class.GetClassType())
+		}) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Item '%s' can only be attuned by a %s", item.Name, strings.Join(item.AttunementClasses, ", "))
+}
+
+// Names of the worn and backpack items the character is attuned to
+func (c *Character) GetAttunedItems() []string {
+	attuned := []string{}
+	for _, slot := range c.wornItemSlots() {
+		if slot.Name != "" && slot.Attuned {
+			attuned = append(attuned, slot.Name)
+		}
+	}
+
+	for _, item := range c.Backpack {
+		if item.Quantity > 0 && item.Attuned {
+			attuned = append(attuned, item.Name)
+		}
+	}
+
+	return attuned
+}
+
+// Attuned items against the limit, ex: '2/3'
+func (c *Character) GetAttunement() string {
+	return fmt.Sprintf("%d/%d", len(c.GetAttunedItems()), shared.MaxAttunedItems)
+}
+
+// Names of known magic items the character has but isn't attuned to. Backpack items are only
+// checked against the known items, since they don't carry their own attunement requirements
+func (c *Character) getUnattunedItems() []string {
+	unattuned := []string{}
+	for _, slot := range c.wornItemSlots() {
+		if item := slot.Resolve(); item.Name != "" && item.RequiresAttunement && !item.Attuned {
+			unattuned = append(unattuned, item.Name)
+		}
+	}
+
+	for _, item := range c.Backpack {
+		magicItem := shared.MagicItem{Name: item.Name}.Resolve()
+		if item.Quantity > 0 && magicItem.RequiresAttunement && !item.Attuned {
+			unattuned = append(unattuned, item.Name)
+		}
+	}
+
+	return unattuned
+}
@@ -0,0 +1,249 @@
+package models
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/onioncall/dndgo/character-management/shared"
+	"github.com/onioncall/dndgo/dice"
+	"github.com/onioncall/dndgo/logger"
+)
+
+const luckyNote = "Lucky: rerolled a natural 1"
+
+// A race with its subrace folded in. Races feed into the character the same way classes do,
+// through a pre-calculate step that runs before stats are derived
+type Race struct {
+	Name    string
+	Subrace string
+	shared.RaceDefinition
+}
+
+// Races are stored lowercase and hyphenated, so 'Half Elf' and 'half-elf' both match
+func normalizeRaceName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+// Looks up the definition for the characters race and subrace.
+// Returns nil when the race is free text that doesn't match one of the known races
+func (c *Character) GetRace() *Race {
+	name := normalizeRaceName(c.Race)
+	def, ok := shared.Races[name]
+	if !ok {
+		return nil
+	}
+
+	race := &Race{
+		Name: name,
+		RaceDefinition: shared.RaceDefinition{
+			AbilityBonuses: maps.Clone(def.AbilityBonuses),
+			AbilityChoices: def.AbilityChoices,
+			Speed:          def.Speed,
+			Darkvision:     def.Darkvision,
+			Languages:      slices.Clone(def.Languages),
+			Resistances:    slices.Clone(def.Resistances),
+			Traits:         slices.Clone(def.Traits),
+		},
+	}
+
+	subrace, ok := def.Subraces[normalizeRaceName(c.Subrace)]
+	if !ok {
+		return race
+	}
+
+	race.Subrace = normalizeRaceName(c.Subrace)
+	if race.AbilityBonuses == nil {
+		race.AbilityBonuses = map[string]int{}
+	}
+	for ability, bonus := range subrace.AbilityBonuses {
+		race.AbilityBonuses[ability] += bonus
+	}
+
+	// Subraces only list what differs from the parent race
+	if subrace.Speed > 0 {
+		race.Speed = subrace.Speed
+	}
+	if subrace.Darkvision > 0 {
+		race.Darkvision = subrace.Darkvision
+	}
+	race.AbilityChoices += subrace.AbilityChoices
+	race.Languages = append(race.Languages, subrace.Languages...)
+	race.Resistances = append(race.Resistances, subrace.Resistances...)
+	race.Traits = append(race.Traits, subrace.Traits...)
+
+	return race
+}
+
+func (r *Race) ExecutePreCalculateMethods(c *Character) {
+	r.executeAbilityBonuses(c)
+	r.executeRaceFeatures(c)
+}
+
+// Racial bonuses are kept apart from the base scores and added when adjusted abilities are calculated
+func (r *Race) executeAbilityBonuses(c *Character) {
+	c.racialBonuses = map[string]int{}
+
+	switch c.RacialBonusMode {
+	case shared.RacialBonusFixed:
+		for ability, bonus := range r.AbilityBonuses {
+			c.racialBonuses[ability] += bonus
+		}
+		for _, item := range c.RacialAbilityBonuses {
+			c.racialBonuses[strings.ToLower(item.Ability)] += item.Bonus
+		}
+	case shared.RacialBonusFlexible:
+		for _, item := range c.RacialAbilityBonuses {
+			c.racialBonuses[strings.ToLower(item.Ability)] += item.Bonus
+		}
+	default:
+		return
+	}
+
+	if err := r.validateRacialBonuses(c.RacialBonusMode, c.RacialAbilityBonuses); err != nil && !c.ValidationDisabled {
+		logger.Info(err.Error())
+	}
+}
+
+func (r *Race) executeRaceFeatures(c *Character) {
+	c.raceSpeed = r.Speed
+	c.Darkvision = r.Darkvision
+	c.Resistances = slices.Clone(r.Resistances)
+	c.RaceTraits = slices.Clone(r.Traits)
+	c.raceLanguages = slices.Clone(r.Languages)
+}
+
+// Fixed bonuses take a +1 for each choice the race offers, on abilities the race doesn't already improve.
+// Flexible bonuses ignore the race's own bonuses, it's a +2 and a +1 or three +1s on any abilities
+func (r *Race) validateRacialBonuses(mode string, items []shared.AbilityScoreImprovementItem) error {
+	switch mode {
+	case shared.RacialBonusFixed:
+		if len(items) != r.AbilityChoices {
+			return fmt.Errorf("Race '%s' has %d ability bonus choices, %d chosen", r.Name, r.AbilityChoices, len(items))
+		}
+
+		for _, item := range items {
+			if item.Bonus != 1 {
+				return fmt.Errorf("Chosen racial bonus for '%s' must be +1", item.Ability)
+			}
+
+			if r.AbilityBonuses[strings.ToLower(item.Ability)] > 0 {
+				return fmt.Errorf("Race '%s' already improves '%s'", r.Name, item.Ability)
+			}
+		}
+	case shared.RacialBonusFlexible:
+		total := 0
+		chosen := map[string]int{}
+		for _, item := range items {
+			total += item.Bonus
+			chosen[strings.ToLower(item.Ability)] += item.Bonus
+		}
+
+		if total != shared.RacialBonusFlexibleTotal {
+			return fmt.Errorf("Flexible racial bonuses must total %d, %d chosen", shared.RacialBonusFlexibleTotal, total)
+		}
+
+		for ability, bonus := range chosen {
+			if bonus > 2 {
+				return fmt.Errorf("Racial ability bonus for '%s' (%d) can't be more than 2", ability, bonus)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sets the characters race and subrace, checking the subrace belongs to the race
+func (c *Character) SetRace(race string, subrace string) error {
+	def, ok := shared.Races[normalizeRaceName(race)]
+	if !ok && !c.ValidationDisabled {
+		return fmt.Errorf("Race '%s' not found", race)
+	}
+
+	if _, ok := def.Subraces[normalizeRaceName(subrace)]; subrace != "" && !ok && !c.ValidationDisabled {
+		return fmt.Errorf("Subrace '%s' not found for race '%s'", subrace, race)
+	}
+
+	c.Race = normalizeRaceName(race)
+	c.Subrace = normalizeRaceName(subrace)
+
+	return nil
+}
+
+// Switches how racial ability bonuses are applied. Abilities are listed in order, in flexible
+// mode the first gets +2 and the second +1 (three abilities get +1 each). In fixed mode each
+// ability is one of the +1 choices races like the half-elf get on top of their fixed bonuses
+func (c *Character) SetRacialBonuses(mode string, abilities []string) error {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode != shared.RacialBonusFixed && mode != shared.RacialBonusFlexible {
+		return fmt.Errorf("Racial bonus mode '%s' is not one of '%s' or '%s'", mode, shared.RacialBonusFixed, shared.RacialBonusFlexible)
+	}
+
+	race := c.GetRace()
+	if race == nil {
+		return fmt.Errorf("Race '%s' not found, set a known race before choosing racial bonuses", c.Race)
+	}
+
+	items := []shared.AbilityScoreImprovementItem{}
+	for i, ability := range abilities {
+		ability = strings.ToLower(strings.TrimSpace(ability))
+		if !slices.ContainsFunc(c.Abilities, func(a shared.Ability) bool { return strings.EqualFold(a.Name, ability) }) {
+			return fmt.Errorf("Ability '%s' not found", ability)
+		}
+
+		bonus := 1
+		if mode == shared.RacialBonusFlexible && i == 0 && len(abilities) == 2 {
+			bonus = 2
+		}
+
+		items = append(items, shared.AbilityScoreImprovementItem{Ability: ability, Bonus: bonus})
+	}
+
+	if err := race.validateRacialBonuses(mode, items); err != nil && !c.ValidationDisabled {
+		return err
+	}
+
+	c.RacialBonusMode = mode
+	c.RacialAbilityBonuses = items
+
+	return nil
+}
+
+// Race name with the subrace in parentheses, ex: 'dwarf (hill)'
+func (c *Character) GetRaceName() string {
+	if c.Subrace == "" {
+		return c.Race
+	}
+
+	return fmt.Sprintf("%s (%s)", c.Race, c.Subrace)
+}
+
+// Languages from the character file with any the race grants, without duplicates
+func (c *Character) GetLanguages() []string {
+	languages := slices.Clone(c.Languages)
+	for _, lang := range c.raceLanguages {
+		if !slices.ContainsFunc(languages, func(l string) bool { return strings.EqualFold(l, lang) }) {
+			languages = append(languages, lang)
+		}
+	}
+
+	return languages
+}
+
+func (c *Character) HasRaceTrait(name string) bool {
+	return slices.ContainsFunc(c.RaceTraits, func(t shared.RaceTrait) bool {
+		return strings.EqualFold(t.Name, name)
+	})
+}
+
+// Rolls a d20 expression, halflings reroll a natural 1 and must use the new roll
+func (c *Character) rollD20(expression string) (dice.Result, bool, error) {
+	result, err := dice.Roll(expression)
+	if err != nil || result.Natural() != 1 || !c.HasRaceTrait(shared.RaceTraitLucky) {
+		return result, false, err
+	}
+
+	result, err = dice.Roll(expression)
+	return result, true, err
+}
@@ -8,9 +8,12 @@ type Ability struct {
 	SavingThrowsProficient bool   `json:"saving-throws-proficient" clover:"saving-throws-proficient"`
 }
 
+// A feat can be taken instead of an ability score improvement, feats with an ability increase
+// keep their +1 in Ability and Bonus
 type AbilityScoreImprovementItem struct {
 	Ability string `json:"ability" clover:"ability"`
 	Bonus   int    `json:"bonus" clover:"bonus"`
+	Feat    string `json:"feat,omitempty" clover:"feat"`
 }
 
 const (
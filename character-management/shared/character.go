@@ -15,6 +15,22 @@ const (
 	ClassWizard    string = "wizard"
 )
 
+// Hit die size for each class, used for hit dice pools and short rests
+var ClassHitDie = map[string]int{
+	ClassBarbarian: 12,
+	ClassBard:      8,
+	ClassCleric:    8,
+	ClassDruid:     8,
+	ClassFighter:   10,
+	ClassMonk:      8,
+	ClassPaladin:   10,
+	ClassRanger:    10,
+	ClassRogue:     8,
+	ClassSorcerer:  6,
+	ClassWarlock:   8,
+	ClassWizard:    6,
+}
+
 const (
 	RaceAasimar      string = "aasimar"
 	RaceDragonborn   string = "dragonborn"
@@ -52,3 +68,44 @@ const (
 	FightingStyleGreatWeaponFighting string = "great-weapon-fighting"
 	FightingStyleProtection          string = "protection"
 )
+
+// Death saves are only tracked while a character is at 0 HP, three of either ends the dying state
+type DeathSaves struct {
+	Successes int `json:"successes" clover:"successes"`
+	Failures  int `json:"failures" clover:"failures"`
+}
+
+const DeathSaveThreshold int = 3
+
+const MaxLevel int = 20
+
+// Experience needed to reach each level, indexed by level - 1
+var XPThresholds = [MaxLevel]int{
+	0, 300, 900, 2700, 6500, 14000, 23000, 34000, 48000, 64000,
+	85000, 100000, 120000, 140000, 165000, 195000, 225000, 265000, 305000, 355000,
+}
+
+// Levels every class gains an ability score improvement at, taking a feat instead is also allowed
+var AbilityScoreImprovementLevels = []int{4, 8, 12, 16, 19}
+
+// Classes that gain ability score improvements beyond the standard levels
+var ClassExtraAbilityScoreImprovementLevels = map[string][]int{
+	ClassFighter: {6, 14},
+	ClassRogue:   {10},
+}
+
+// Level each class chooses their subclass at
+var ClassSubClassLevel = map[string]int{
+	ClassBarbarian: 3,
+	ClassBard:      3,
+	ClassCleric:    1,
+	ClassDruid:     2,
+	ClassFighter:   3,
+	ClassMonk:      3,
+	ClassPaladin:   3,
+	ClassRanger:    3,
+	ClassRogue:     3,
+	ClassSorcerer:  1,
+	ClassWarlock:   1,
+	ClassWizard:    2,
+}
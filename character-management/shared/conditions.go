@@ -0,0 +1,35 @@
+package shared
+
+const (
+	ConditionPoisoned   string = "poisoned"
+	ConditionProne      string = "prone"
+	ConditionRestrained string = "restrained"
+	ConditionFrightened string = "frightened"
+	ConditionExhaustion string = "exhaustion"
+)
+
+// A character with six levels of exhaustion dies
+const ExhaustionMaxLevel int = 6
+
+const (
+	RollTypeAbilityCheck string = "ability-check"
+	RollTypeAttack       string = "attack"
+	RollTypeSavingThrow  string = "saving-throw"
+)
+
+var Conditions = []string{
+	ConditionPoisoned,
+	ConditionProne,
+	ConditionRestrained,
+	ConditionFrightened,
+	ConditionExhaustion,
+}
+
+// Roll types each condition imposes disadvantage on. Restrained only affects dexterity saving throws,
+// which is handled where the ability is known
+var ConditionDisadvantage = map[string][]string{
+	ConditionPoisoned:   {RollTypeAttack, RollTypeAbilityCheck},
+	ConditionProne:      {RollTypeAttack},
+	ConditionRestrained: {RollTypeAttack},
+	ConditionFrightened: {RollTypeAttack, RollTypeAbilityCheck},
+}
@@ -1,16 +1,19 @@
 package shared
 
 type WornEquipment struct {
-	Head      string `json:"head"`
-	Amulet    string `json:"amulet"`
-	Cloak     string `json:"cloak"`
-	Armor     Armor  `json:"armor"`
-	HandsArms string `json:"hands-arms" clover:"hands-arms"`
-	Ring      string `json:"ring"`
-	Ring2     string `json:"ring2"`
-	Belt      string `json:"belt"`
-	Boots     string `json:"boots"`
-	Shield    string `json:"shield"`
+	Head      MagicItem `json:"head"`
+	Amulet    MagicItem `json:"amulet"`
+	Cloak     MagicItem `json:"cloak"`
+	Armor     Armor     `json:"armor"`
+	HandsArms MagicItem `json:"hands-arms" clover:"hands-arms"`
+	Ring      MagicItem `json:"ring"`
+	Ring2     MagicItem `json:"ring2"`
+	Belt      MagicItem `json:"belt"`
+	Boots     MagicItem `json:"boots"`
+	Shield    string    `json:"shield"`
+
+	// Weight in pounds of each piece of worn equipment, keyed by equipment type
+	Weights map[string]float64 `json:"weights"`
 }
 
 type Armor struct {
@@ -21,8 +24,10 @@ type Armor struct {
 }
 
 type BackpackItem struct {
-	Name     string `json:"name"`
-	Quantity int    `json:"quantity"`
+	Name     string  `json:"name"`
+	Quantity int     `json:"quantity"`
+	Weight   float64 `json:"weight"` // pounds per item
+	Attuned  bool    `json:"attuned,omitempty" clover:"attuned"`
 }
 
 type Equipped string
@@ -62,3 +67,59 @@ var WornEquipmentTypes = [9]string{
 	WornEquipmentRing,
 	WornEquipmentRing2,
 }
+
+type Purse struct {
+	Copper   int `json:"cp" clover:"cp"`
+	Silver   int `json:"sp" clover:"sp"`
+	Electrum int `json:"ep" clover:"ep"`
+	Gold     int `json:"gp" clover:"gp"`
+	Platinum int `json:"pp" clover:"pp"`
+}
+
+const (
+	CurrencyCopper   string = "cp"
+	CurrencySilver   string = "sp"
+	CurrencyElectrum string = "ep"
+	CurrencyGold     string = "gp"
+	CurrencyPlatinum string = "pp"
+)
+
+// Denominations from most to least valuable
+var Currencies = []string{
+	CurrencyPlatinum,
+	CurrencyGold,
+	CurrencyElectrum,
+	CurrencySilver,
+	CurrencyCopper,
+}
+
+// Value of each denomination in copper pieces
+var CurrencyValues = map[string]int{
+	CurrencyPlatinum: 1000,
+	CurrencyGold:     100,
+	CurrencyElectrum: 50,
+	CurrencySilver:   10,
+	CurrencyCopper:   1,
+}
+
+// Carrying capacity and the variant encumbrance thresholds are multiples of the strength score
+const (
+	CarryingCapacityMultiplier  int = 15
+	EncumberedMultiplier        int = 5
+	HeavilyEncumberedMultiplier int = 10
+)
+
+const (
+	EncumbranceNone       string = "unencumbered"
+	EncumbranceEncumbered string = "encumbered"
+	EncumbranceHeavy      string = "heavily encumbered"
+	EncumbranceOver       string = "over capacity"
+)
+
+var EncumbranceSpeedPenalty = map[string]int{
+	EncumbranceEncumbered: 10,
+	EncumbranceHeavy:      20,
+}
+
+// Fifty coins of any denomination weigh a pound
+const CoinsPerPound int = 50
@@ -0,0 +1,64 @@
+package shared
+
+const (
+	FeatAlert             string = "alert"
+	FeatGreatWeaponMaster string = "great weapon master"
+	FeatMobile            string = "mobile"
+	FeatObservant         string = "observant"
+	FeatResilient         string = "resilient"
+	FeatSharpshooter      string = "sharpshooter"
+	FeatTough             string = "tough"
+	FeatWarCaster         string = "war caster"
+)
+
+// An ability score improvement is worth two points, a feat taken in its place uses all of them
+const AbilityScoreImprovementPoints int = 2
+
+var allAbilities = []string{
+	AbilityStrength, AbilityDexterity, AbilityConstitution, AbilityIntelligence, AbilityWisdom, AbilityCharisma,
+}
+
+type FeatDefinition struct {
+	Details string
+	// One of these abilities gains +1 when the feat is taken, empty for feats without an ability increase
+	Abilities       []string
+	SaveProficiency bool
+	HPPerLevel      int
+	Initiative      int
+	Speed           int
+	PassiveBonus    int
+}
+
+var Feats = map[string]FeatDefinition{
+	FeatAlert: {
+		Details:    "+5 to initiative, you can't be surprised while conscious, and hidden attackers don't gain advantage against you",
+		Initiative: 5,
+	},
+	FeatGreatWeaponMaster: {
+		Details: "Take -5 to hit with a heavy melee weapon for +10 damage, and make a bonus attack after a critical hit or a kill",
+	},
+	FeatMobile: {
+		Details: "Speed increases by 10, dashing ignores difficult terrain, and creatures you attack can't make opportunity attacks against you",
+		Speed:   10,
+	},
+	FeatObservant: {
+		Details:      "+1 intelligence or wisdom, +5 to passive perception and passive investigation, and you can read lips",
+		Abilities:    []string{AbilityIntelligence, AbilityWisdom},
+		PassiveBonus: 5,
+	},
+	FeatResilient: {
+		Details:         "+1 to an ability, and proficiency in saving throws using that ability",
+		Abilities:       allAbilities,
+		SaveProficiency: true,
+	},
+	FeatSharpshooter: {
+		Details: "Take -5 to hit with a ranged weapon for +10 damage, long range attacks have no disadvantage, and cover is ignored",
+	},
+	FeatTough: {
+		Details:    "Hit point maximum increases by 2 for every level",
+		HPPerLevel: 2,
+	},
+	FeatWarCaster: {
+		Details: "Advantage on concentration saves, cast with your hands full, and cast a spell as an opportunity attack",
+	},
+}
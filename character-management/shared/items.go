@@ -0,0 +1,117 @@
+package shared
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// A worn item, magic items carry modifiers that are applied when stats are calculated.
+// Items without modifiers of their own use the known item with the same name
+type MagicItem struct {
+	Name      string `json:"name" clover:"name"`
+	ACBonus   int    `json:"ac-bonus,omitempty" clover:"ac-bonus"`
+	SaveBonus int    `json:"save-bonus,omitempty" clover:"save-bonus"`
+	// Ability scores the item sets, ex: a belt of hill giant strength sets strength to 21.
+	// Scores already higher than the item's are left alone
+	AbilityScores map[string]int `json:"ability-scores,omitempty" clover:"ability-scores"`
+	Speed         int            `json:"speed,omitempty" clover:"speed"`
+	Resistances   []string       `json:"resistances,omitempty" clover:"resistances"`
+
+	// Items that require attunement only apply their modifiers while attuned. Some can only be
+	// attuned by certain classes, ex: a robe of the archmagi
+	RequiresAttunement bool     `json:"requires-attunement,omitempty" clover:"requires-attunement"`
+	AttunementClasses  []string `json:"attunement-classes,omitempty" clover:"attunement-classes"`
+	Attuned            bool     `json:"attuned,omitempty" clover:"attuned"`
+}
+
+// A character can be attuned to at most three magic items at once
+const MaxAttunedItems int = 3
+
+// Worn equipment used to be saved as plain names, those are read in as items without modifiers
+func (m *MagicItem) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*m = MagicItem{Name: name}
+		return nil
+	}
+
+	type magicItem MagicItem
+	return json.Unmarshal(data, (*magicItem)(m))
+}
+
+func (m MagicItem) HasModifiers() bool {
+	return m.ACBonus != 0 || m.SaveBonus != 0 || len(m.AbilityScores) > 0 || m.Speed != 0 || len(m.Resistances) > 0
+}
+
+// Returns the item with the modifiers of the matching known item filled in, when it has none of its own.
+// Attunement always comes from the item itself, along with the known item's requirements
+func (m MagicItem) Resolve() MagicItem {
+	known, ok := MagicItems[strings.ToLower(strings.TrimSpace(m.Name))]
+	if !ok {
+		return m
+	}
+
+	m.RequiresAttunement = m.RequiresAttunement || known.RequiresAttunement
+	if len(m.AttunementClasses) == 0 {
+		m.AttunementClasses = slices.Clone(known.AttunementClasses)
+	}
+
+	if m.HasModifiers() {
+		return m
+	}
+
+	m.ACBonus = known.ACBonus
+	m.SaveBonus = known.SaveBonus
+	m.AbilityScores = maps.Clone(known.AbilityScores)
+	m.Speed = known.Speed
+	m.Resistances = slices.Clone(known.Resistances)
+
+	return m
+}
+
+const (
+	MagicItemRingOfProtection          string = "ring of protection"
+	MagicItemCloakOfProtection         string = "cloak of protection"
+	MagicItemBeltOfHillGiantStrength   string = "belt of hill giant strength"
+	MagicItemBeltOfStoneGiantStrength  string = "belt of stone giant strength"
+	MagicItemBeltOfFrostGiantStrength  string = "belt of frost giant strength"
+	MagicItemBeltOfFireGiantStrength   string = "belt of fire giant strength"
+	MagicItemBeltOfCloudGiantStrength  string = "belt of cloud giant strength"
+	MagicItemBeltOfStormGiantStrength  string = "belt of storm giant strength"
+	MagicItemGauntletsOfOgrePower      string = "gauntlets of ogre power"
+	MagicItemHeadbandOfIntellect       string = "headband of intellect"
+	MagicItemAmuletOfHealth            string = "amulet of health"
+	MagicItemBootsOfTheWinterlands     string = "boots of the winterlands"
+	MagicItemRingOfFireResistance      string = "ring of fire resistance"
+	MagicItemRingOfColdResistance      string = "ring of cold resistance"
+	MagicItemRingOfLightningResistance string = "ring of lightning resistance"
+	MagicItemRingOfPoisonResistance    string = "ring of poison resistance"
+	MagicItemRobeOfTheArchmagi         string = "robe of the archmagi"
+)
+
+// Modifiers and attunement for magic items from the dungeon masters guide. Items with effects dndgo doesn't
+// track (like bracers of archery) are left out unless their attunement is restricted, they can still be worn by name
+var MagicItems = map[string]MagicItem{
+	MagicItemRingOfProtection:          {ACBonus: 1, SaveBonus: 1, RequiresAttunement: true},
+	MagicItemCloakOfProtection:         {ACBonus: 1, SaveBonus: 1, RequiresAttunement: true},
+	MagicItemBeltOfHillGiantStrength:   {AbilityScores: map[string]int{AbilityStrength: 21}, RequiresAttunement: true},
+	MagicItemBeltOfStoneGiantStrength:  {AbilityScores: map[string]int{AbilityStrength: 23}, RequiresAttunement: true},
+	MagicItemBeltOfFrostGiantStrength:  {AbilityScores: map[string]int{AbilityStrength: 23}, RequiresAttunement: true},
+	MagicItemBeltOfFireGiantStrength:   {AbilityScores: map[string]int{AbilityStrength: 25}, RequiresAttunement: true},
+	MagicItemBeltOfCloudGiantStrength:  {AbilityScores: map[string]int{AbilityStrength: 27}, RequiresAttunement: true},
+	MagicItemBeltOfStormGiantStrength:  {AbilityScores: map[string]int{AbilityStrength: 29}, RequiresAttunement: true},
+	MagicItemGauntletsOfOgrePower:      {AbilityScores: map[string]int{AbilityStrength: 19}, RequiresAttunement: true},
+	MagicItemHeadbandOfIntellect:       {AbilityScores: map[string]int{AbilityIntelligence: 19}, RequiresAttunement: true},
+	MagicItemAmuletOfHealth:            {AbilityScores: map[string]int{AbilityConstitution: 19}, RequiresAttunement: true},
+	MagicItemBootsOfTheWinterlands:     {Resistances: []string{DamageCold}, RequiresAttunement: true},
+	MagicItemRingOfFireResistance:      {Resistances: []string{DamageFire}, RequiresAttunement: true},
+	MagicItemRingOfColdResistance:      {Resistances: []string{DamageCold}, RequiresAttunement: true},
+	MagicItemRingOfLightningResistance: {Resistances: []string{DamageLightning}, RequiresAttunement: true},
+	MagicItemRingOfPoisonResistance:    {Resistances: []string{DamagePoison}, RequiresAttunement: true},
+	MagicItemRobeOfTheArchmagi: {
+		RequiresAttunement: true,
+		AttunementClasses:  []string{ClassSorcerer, ClassWarlock, ClassWizard},
+	},
+}
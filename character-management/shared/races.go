@@ -0,0 +1,433 @@
+package shared
+
+// Racial ability bonuses are either the fixed bonuses listed for the race, or a flexible +2/+1
+// (or three +1s) the player assigns to any abilities. Left empty, the base ability scores are
+// assumed to already include them
+const (
+	RacialBonusFixed    string = "fixed"
+	RacialBonusFlexible string = "flexible"
+)
+
+const RacialBonusFlexibleTotal int = 3
+
+const (
+	RaceTraitLucky             string = "Lucky"
+	RaceTraitDwarvenResilience string = "Dwarven Resilience"
+)
+
+const (
+	DamageAcid      string = "acid"
+	DamageCold      string = "cold"
+	DamageFire      string = "fire"
+	DamageLightning string = "lightning"
+	DamageNecrotic  string = "necrotic"
+	DamagePoison    string = "poison"
+	DamagePsychic   string = "psychic"
+	DamageRadiant   string = "radiant"
+)
+
+type RaceTrait struct {
+	Name    string
+	Details string
+	// Saving throws the trait gives advantage on, shown alongside any disadvantage when rolling
+	SaveAdvantage string
+}
+
+type RaceDefinition struct {
+	AbilityBonuses map[string]int
+	// Number of +1 bonuses the player chooses themselves on top of the fixed bonuses, ex: half-elf
+	AbilityChoices int
+	Speed          int
+	Darkvision     int
+	Languages      []string
+	Resistances    []string
+	Traits         []RaceTrait
+	Subraces       map[string]RaceDefinition
+}
+
+var dragonbornAncestry = map[string]string{
+	"black":  DamageAcid,
+	"blue":   DamageLightning,
+	"brass":  DamageFire,
+	"bronze": DamageLightning,
+	"copper": DamageAcid,
+	"gold":   DamageFire,
+	"green":  DamagePoison,
+	"red":    DamageFire,
+	"silver": DamageCold,
+	"white":  DamageCold,
+}
+
+var Races = map[string]RaceDefinition{
+	RaceAasimar: {
+		AbilityBonuses: map[string]int{AbilityCharisma: 2},
+		Speed:          30,
+		Darkvision:     60,
+		Languages:      []string{"Common", "Celestial"},
+		Resistances:    []string{DamageNecrotic, DamageRadiant},
+		Traits: []RaceTrait{
+			{Name: "Celestial Resistance", Details: "Resistance to necrotic and radiant damage"},
+			{Name: "Healing Hands", Details: "Touch a creature to restore hit points equal to your level, once per long rest"},
+			{Name: "Light Bearer", Details: "You know the light cantrip, charisma is your spellcasting ability for it"},
+		},
+		Subraces: map[string]RaceDefinition{
+			"protector": {
+				AbilityBonuses: map[string]int{AbilityWisdom: 1},
+				Traits:         []RaceTrait{{Name: "Radiant Soul", Details: "From level 3, sprout wings for 1 minute with a flying speed of 30 and extra radiant damage"}},
+			},
+			"scourge": {
+				AbilityBonuses: map[string]int{AbilityConstitution: 1},
+				Traits:         []RaceTrait{{Name: "Radiant Consumption", Details: "From level 3, shed searing light for 1 minute dealing radiant damage to you and nearby creatures"}},
+			},
+			"fallen": {
+				AbilityBonuses: map[string]int{AbilityStrength: 1},
+				Traits:         []RaceTrait{{Name: "Necrotic Shroud", Details: "From level 3, frighten nearby creatures and deal extra necrotic damage for 1 minute"}},
+			},
+		},
+	},
+	RaceDragonborn: {
+		AbilityBonuses: map[string]int{AbilityStrength: 2, AbilityCharisma: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Draconic"},
+		Traits: []RaceTrait{
+			{Name: "Draconic Ancestry", Details: "Your subrace is your dragon type, which sets your breath weapon and resistance"},
+			{Name: "Breath Weapon", Details: "Exhale destructive energy once per short or long rest, DC 8 + con mod + proficiency"},
+		},
+		Subraces: dragonbornSubraces(),
+	},
+	RaceDwarf: {
+		AbilityBonuses: map[string]int{AbilityConstitution: 2},
+		Speed:          25,
+		Darkvision:     60,
+		Languages:      []string{"Common", "Dwarvish"},
+		Resistances:    []string{DamagePoison},
+		Traits: []RaceTrait{
+			{Name: RaceTraitDwarvenResilience, Details: "Advantage on saving throws against poison, and resistance to poison damage", SaveAdvantage: "poison"},
+			{Name: "Dwarven Combat Training", Details: "Proficiency with the battleaxe, handaxe, light hammer, and warhammer"},
+			{Name: "Stonecunning", Details: "Double proficiency on history checks related to the origin of stonework"},
+			{Name: "Heavy Armor Speed", Details: "Your speed is not reduced by wearing heavy armor"},
+		},
+		Subraces: map[string]RaceDefinition{
+			"hill": {
+				AbilityBonuses: map[string]int{AbilityWisdom: 1},
+				Traits:         []RaceTrait{{Name: "Dwarven Toughness", Details: "Your hit point maximum increases by 1 every level"}},
+			},
+			"mountain": {
+				AbilityBonuses: map[string]int{AbilityStrength: 2},
+				Traits:         []RaceTrait{{Name: "Dwarven Armor Training", Details: "Proficiency with light and medium armor"}},
+			},
+		},
+	},
+	RaceElf: {
+		AbilityBonuses: map[string]int{AbilityDexterity: 2},
+		Speed:          30,
+		Darkvision:     60,
+		Languages:      []string{"Common", "Elvish"},
+		Traits: []RaceTrait{
+			{Name: "Keen Senses", Details: "Proficiency in the perception skill"},
+			{Name: "Fey Ancestry", Details: "Advantage on saving throws against being charmed, and magic can't put you to sleep", SaveAdvantage: "charmed"},
+			{Name: "Trance", Details: "Meditate for 4 hours instead of sleeping"},
+		},
+		Subraces: map[string]RaceDefinition{
+			"high": {
+				AbilityBonuses: map[string]int{AbilityIntelligence: 1},
+				Traits:         []RaceTrait{{Name: "Cantrip", Details: "You know one wizard cantrip, intelligence is your spellcasting ability for it"}},
+			},
+			"wood": {
+				AbilityBonuses: map[string]int{AbilityWisdom: 1},
+				Speed:          35,
+				Traits:         []RaceTrait{{Name: "Mask of the Wild", Details: "Attempt to hide when only lightly obscured by natural phenomena"}},
+			},
+			"drow": {
+				AbilityBonuses: map[string]int{AbilityCharisma: 1},
+				Darkvision:     120,
+				Traits: []RaceTrait{
+					{Name: "Sunlight Sensitivity", Details: "Disadvantage on attack rolls and sight based perception checks in direct sunlight"},
+					{Name: "Drow Magic", Details: "You know the dancing lights cantrip, and later faerie fire and darkness"},
+				},
+			},
+		},
+	},
+	RaceGnome: {
+		AbilityBonuses: map[string]int{AbilityIntelligence: 2},
+		Speed:          25,
+		Darkvision:     60,
+		Languages:      []string{"Common", "Gnomish"},
+		Traits: []RaceTrait{
+			{Name: "Gnome Cunning", Details: "Advantage on intelligence, wisdom, and charisma saving throws against magic", SaveAdvantage: "int, wis, cha vs magic"},
+		},
+		Subraces: map[string]RaceDefinition{
+			"forest": {
+				AbilityBonuses: map[string]int{AbilityDexterity: 1},
+				Traits:         []RaceTrait{{Name: "Speak with Small Beasts", Details: "Communicate simple ideas with small or smaller beasts"}},
+			},
+			"rock": {
+				AbilityBonuses: map[string]int{AbilityConstitution: 1},
+				Traits:         []RaceTrait{{Name: "Tinker", Details: "Construct tiny clockwork devices with tinker's tools"}},
+			},
+		},
+	},
+	RaceHalfElf: {
+		AbilityBonuses: map[string]int{AbilityCharisma: 2},
+		AbilityChoices: 2,
+		Speed:          30,
+		Darkvision:     60,
+		Languages:      []string{"Common", "Elvish"},
+		Traits: []RaceTrait{
+			{Name: "Fey Ancestry", Details: "Advantage on saving throws against being charmed, and magic can't put you to sleep", SaveAdvantage: "charmed"},
+			{Name: "Skill Versatility", Details: "Proficiency in two skills of your choice"},
+		},
+	},
+	RaceHalfOrc: {
+		AbilityBonuses: map[string]int{AbilityStrength: 2, AbilityConstitution: 1},
+		Speed:          30,
+		Darkvision:     60,
+		Languages:      []string{"Common", "Orc"},
+		Traits: []RaceTrait{
+			{Name: "Menacing", Details: "Proficiency in the intimidation skill"},
+			{Name: "Relentless Endurance", Details: "Drop to 1 hit point instead of 0 once per long rest"},
+			{Name: "Savage Attacks", Details: "Roll one extra weapon damage die on a melee critical hit"},
+		},
+	},
+	RaceHalfling: {
+		AbilityBonuses: map[string]int{AbilityDexterity: 2},
+		Speed:          25,
+		Languages:      []string{"Common", "Halfling"},
+		Traits: []RaceTrait{
+			{Name: RaceTraitLucky, Details: "Reroll a natural 1 on an attack roll, ability check, or saving throw"},
+			{Name: "Brave", Details: "Advantage on saving throws against being frightened", SaveAdvantage: "frightened"},
+			{Name: "Halfling Nimbleness", Details: "Move through the space of any creature larger than you"},
+		},
+		Subraces: map[string]RaceDefinition{
+			"lightfoot": {
+				AbilityBonuses: map[string]int{AbilityCharisma: 1},
+				Traits:         []RaceTrait{{Name: "Naturally Stealthy", Details: "Attempt to hide when obscured only by a creature larger than you"}},
+			},
+			"stout": {
+				AbilityBonuses: map[string]int{AbilityConstitution: 1},
+				Resistances:    []string{DamagePoison},
+				Traits:         []RaceTrait{{Name: "Stout Resilience", Details: "Advantage on saving throws against poison, and resistance to poison damage", SaveAdvantage: "poison"}},
+			},
+		},
+	},
+	RaceHuman: {
+		AbilityBonuses: map[string]int{
+			AbilityStrength: 1, AbilityDexterity: 1, AbilityConstitution: 1,
+			AbilityIntelligence: 1, AbilityWisdom: 1, AbilityCharisma: 1,
+		},
+		Speed:     30,
+		Languages: []string{"Common"},
+	},
+	RaceVariantHuman: {
+		AbilityChoices: 2,
+		Speed:          30,
+		Languages:      []string{"Common"},
+		Traits: []RaceTrait{
+			{Name: "Skills", Details: "Proficiency in one skill of your choice"},
+			{Name: "Feat", Details: "You gain one feat of your choice"},
+		},
+	},
+	RaceTiefling: {
+		AbilityBonuses: map[string]int{AbilityCharisma: 2, AbilityIntelligence: 1},
+		Speed:          30,
+		Darkvision:     60,
+		Languages:      []string{"Common", "Infernal"},
+		Resistances:    []string{DamageFire},
+		Traits: []RaceTrait{
+			{Name: "Hellish Resistance", Details: "Resistance to fire damage"},
+			{Name: "Infernal Legacy", Details: "You know the thaumaturgy cantrip, and later hellish rebuke and darkness"},
+		},
+	},
+	RaceGoliath: {
+		AbilityBonuses: map[string]int{AbilityStrength: 2, AbilityConstitution: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Giant"},
+		Resistances:    []string{DamageCold},
+		Traits: []RaceTrait{
+			{Name: "Natural Athlete", Details: "Proficiency in the athletics skill"},
+			{Name: "Stone's Endurance", Details: "Reduce damage taken by 1d12 + con mod once per short or long rest"},
+			{Name: "Powerful Build", Details: "Count as one size larger for carrying capacity"},
+			{Name: "Mountain Born", Details: "Resistance to cold damage, and acclimated to high altitude"},
+		},
+	},
+	RaceFirebolt: {
+		AbilityBonuses: map[string]int{AbilityWisdom: 2, AbilityStrength: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Elvish", "Giant"},
+		Traits: []RaceTrait{
+			{Name: "Firbolg Magic", Details: "Cast detect magic and disguise self once per short or long rest"},
+			{Name: "Hidden Step", Details: "Turn invisible as a bonus action once per short or long rest"},
+			{Name: "Powerful Build", Details: "Count as one size larger for carrying capacity"},
+			{Name: "Speech of Beast and Leaf", Details: "Communicate in a limited manner with beasts and plants"},
+		},
+	},
+	RaceTabaxi: {
+		AbilityBonuses: map[string]int{AbilityDexterity: 2, AbilityCharisma: 1},
+		Speed:          30,
+		Darkvision:     60,
+		Languages:      []string{"Common"},
+		Traits: []RaceTrait{
+			{Name: "Feline Agility", Details: "Double your speed for a turn, usable again after a turn without moving"},
+			{Name: "Cat's Claws", Details: "Climbing speed of 20, and unarmed strikes deal 1d4 slashing damage"},
+			{Name: "Cat's Talent", Details: "Proficiency in the perception and stealth skills"},
+		},
+	},
+	RaceKenku: {
+		AbilityBonuses: map[string]int{AbilityDexterity: 2, AbilityWisdom: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Auran"},
+		Traits: []RaceTrait{
+			{Name: "Expert Forgery", Details: "Advantage on checks to produce forgeries or duplicates of existing objects"},
+			{Name: "Kenku Training", Details: "Proficiency in two of acrobatics, deception, stealth, and sleight of hand"},
+			{Name: "Mimicry", Details: "Mimic sounds you have heard, including voices"},
+		},
+	},
+	RaceTriton: {
+		AbilityBonuses: map[string]int{AbilityStrength: 1, AbilityConstitution: 1, AbilityCharisma: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Primordial"},
+		Resistances:    []string{DamageCold},
+		Traits: []RaceTrait{
+			{Name: "Amphibious", Details: "Breathe air and water, with a swimming speed of 30"},
+			{Name: "Control Air and Water", Details: "Cast fog cloud, and later gust of wind and wall of water"},
+			{Name: "Emissary of the Sea", Details: "Communicate simple ideas with beasts that breathe water"},
+			{Name: "Guardians of the Depths", Details: "Resistance to cold damage, and ignore the drawbacks of a deep underwater environment"},
+		},
+	},
+	RaceWarforged: {
+		AbilityBonuses: map[string]int{AbilityConstitution: 2},
+		AbilityChoices: 1,
+		Speed:          30,
+		Languages:      []string{"Common"},
+		Resistances:    []string{DamagePoison},
+		Traits: []RaceTrait{
+			{Name: "Constructed Resilience", Details: "Advantage on saving throws against being poisoned, resistance to poison damage, and no need to eat, drink, or breathe", SaveAdvantage: "poisoned"},
+			{Name: "Sentry's Rest", Details: "Spend a long rest inactive but conscious for 6 hours"},
+			{Name: "Integrated Protection", Details: "+1 bonus to AC, and armor can't be removed against your will"},
+		},
+	},
+	RaceChangeling: {
+		AbilityBonuses: map[string]int{AbilityCharisma: 2},
+		AbilityChoices: 1,
+		Speed:          30,
+		Languages:      []string{"Common"},
+		Traits: []RaceTrait{
+			{Name: "Shapechanger", Details: "Change your appearance and voice as an action"},
+			{Name: "Changeling Instincts", Details: "Proficiency in two of deception, insight, intimidation, and persuasion"},
+		},
+	},
+	RaceKalashtar: {
+		AbilityBonuses: map[string]int{AbilityWisdom: 2, AbilityCharisma: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Quori"},
+		Resistances:    []string{DamagePsychic},
+		Traits: []RaceTrait{
+			{Name: "Dual Mind", Details: "Advantage on wisdom saving throws", SaveAdvantage: "wis"},
+			{Name: "Mental Discipline", Details: "Resistance to psychic damage"},
+			{Name: "Mind Link", Details: "Speak telepathically to a creature you can see"},
+			{Name: "Severed from Dreams", Details: "Immune to spells and effects that require you to dream"},
+		},
+	},
+	RaceShifter: {
+		Speed:      30,
+		Darkvision: 60,
+		Languages:  []string{"Common"},
+		Traits: []RaceTrait{
+			{Name: "Shifting", Details: "Gain temporary hit points and a subrace benefit for 1 minute, once per short or long rest"},
+		},
+		Subraces: map[string]RaceDefinition{
+			"beasthide": {
+				AbilityBonuses: map[string]int{AbilityConstitution: 2, AbilityStrength: 1},
+				Traits:         []RaceTrait{{Name: "Shifting Feature", Details: "Gain an extra 1d6 temporary hit points and +1 AC while shifted"}},
+			},
+			"longtooth": {
+				AbilityBonuses: map[string]int{AbilityStrength: 2, AbilityDexterity: 1},
+				Traits:         []RaceTrait{{Name: "Shifting Feature", Details: "Make a 1d6 piercing bite attack as a bonus action while shifted"}},
+			},
+			"swiftstride": {
+				AbilityBonuses: map[string]int{AbilityDexterity: 2, AbilityCharisma: 1},
+				Traits:         []RaceTrait{{Name: "Shifting Feature", Details: "Speed increases by 10 while shifted"}},
+			},
+			"wildhunt": {
+				AbilityBonuses: map[string]int{AbilityWisdom: 2, AbilityDexterity: 1},
+				Traits:         []RaceTrait{{Name: "Shifting Feature", Details: "Advantage on wisdom checks while shifted"}},
+			},
+		},
+	},
+	RaceOrc: {
+		AbilityBonuses: map[string]int{AbilityStrength: 2, AbilityConstitution: 1, AbilityIntelligence: -2},
+		Speed:          30,
+		Darkvision:     60,
+		Languages:      []string{"Common", "Orc"},
+		Traits: []RaceTrait{
+			{Name: "Aggressive", Details: "Move up to your speed toward an enemy as a bonus action"},
+			{Name: "Menacing", Details: "Proficiency in the intimidation skill"},
+			{Name: "Powerful Build", Details: "Count as one size larger for carrying capacity"},
+		},
+	},
+	RaceKobold: {
+		AbilityBonuses: map[string]int{AbilityDexterity: 2, AbilityStrength: -2},
+		Speed:          30,
+		Darkvision:     60,
+		Languages:      []string{"Common", "Draconic"},
+		Traits: []RaceTrait{
+			{Name: "Grovel, Cower, and Beg", Details: "Allies gain advantage against nearby enemies once per short or long rest"},
+			{Name: "Pack Tactics", Details: "Advantage on attacks when an ally is within 5 feet of the target"},
+			{Name: "Sunlight Sensitivity", Details: "Disadvantage on attack rolls and sight based perception checks in direct sunlight"},
+		},
+	},
+	RaceLizardfolk: {
+		AbilityBonuses: map[string]int{AbilityConstitution: 2, AbilityWisdom: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Draconic"},
+		Traits: []RaceTrait{
+			{Name: "Bite", Details: "Unarmed strikes with your bite deal 1d6 piercing damage"},
+			{Name: "Hold Breath", Details: "Hold your breath for up to 15 minutes, with a swimming speed of 30"},
+			{Name: "Natural Armor", Details: "AC of 13 + dex mod when not wearing armor"},
+			{Name: "Hungry Jaws", Details: "Bite as a bonus action and gain temporary hit points once per short or long rest"},
+		},
+	},
+	RaceTortle: {
+		AbilityBonuses: map[string]int{AbilityStrength: 2, AbilityWisdom: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Aquan"},
+		Traits: []RaceTrait{
+			{Name: "Claws", Details: "Unarmed strikes deal 1d4 slashing damage"},
+			{Name: "Hold Breath", Details: "Hold your breath for up to 1 hour"},
+			{Name: "Natural Armor", Details: "Base AC of 17, dexterity doesn't apply and armor can't be worn"},
+			{Name: "Shell Defense", Details: "Withdraw into your shell for +4 AC and advantage on str and con saves"},
+		},
+	},
+	RaceGithyanki: {
+		AbilityBonuses: map[string]int{AbilityStrength: 2, AbilityIntelligence: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Gith"},
+		Traits: []RaceTrait{
+			{Name: "Decadent Mastery", Details: "Proficiency in one skill or tool and one language of your choice"},
+			{Name: "Martial Prodigy", Details: "Proficiency with light and medium armor, shortswords, longswords, and greatswords"},
+			{Name: "Githyanki Psionics", Details: "You know the mage hand cantrip, and later jump and misty step"},
+		},
+	},
+	RaceGithzerai: {
+		AbilityBonuses: map[string]int{AbilityWisdom: 2, AbilityIntelligence: 1},
+		Speed:          30,
+		Languages:      []string{"Common", "Gith"},
+		Traits: []RaceTrait{
+			{Name: "Mental Discipline", Details: "Advantage on saving throws against being charmed or frightened", SaveAdvantage: "charmed, frightened"},
+			{Name: "Githzerai Psionics", Details: "You know the mage hand cantrip, and later shield and detect thoughts"},
+		},
+	},
+}
+
+// Each dragon color is a subrace, granting resistance to the damage type of its breath weapon
+func dragonbornSubraces() map[string]RaceDefinition {
+	subraces := map[string]RaceDefinition{}
+	for color, damage := range dragonbornAncestry {
+		subraces[color] = RaceDefinition{
+			Resistances: []string{damage},
+			Traits:      []RaceTrait{{Name: "Damage Resistance", Details: "Resistance to " + damage + " damage, which is also your breath weapon's damage type"}},
+		}
+	}
+
+	return subraces
+}
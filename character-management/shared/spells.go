@@ -1,16 +1,24 @@
 package shared
 
+// Maximum is calculated from class levels unless Manual is set, Expected always holds the calculated value.
+// Created slots come from sorcery points, they are counted in Available and vanish on a long rest
 type SpellSlot struct {
-	Level     int `json:"level" clover:"level"`
-	Maximum   int `json:"maximum" clover:"maximum"`
-	Available int `json:"available" clover:"available"`
+	Level     int  `json:"level" clover:"level"`
+	Maximum   int  `json:"maximum" clover:"maximum"`
+	Available int  `json:"available" clover:"available"`
+	Manual    bool `json:"manual,omitempty" clover:"manual"`
+	Created   int  `json:"created,omitempty" clover:"created"`
+	Expected  int  `json:"expected,omitempty" clover:"expected"`
 }
 
 type CharacterSpell struct {
-	SlotLevel  int    `json:"slot-level" clover:"slot-level"`
-	IsRitual   bool   `json:"ritual" clover:"ritual"`
-	Name       string `json:"name" clover:"name"`
-	IsPrepared bool   `json:"-" clover:"-"`
+	SlotLevel       int    `json:"slot-level" clover:"slot-level"`
+	IsRitual        bool   `json:"ritual" clover:"ritual"`
+	Name            string `json:"name" clover:"name"`
+	IsConcentration bool   `json:"concentration" clover:"concentration"`
+	Duration        string `json:"duration" clover:"duration"`
+	ClassType       string `json:"class-type,omitempty" clover:"class-type"`
+	IsPrepared      bool   `json:"-" clover:"-"`
 }
 
 type Token struct {
@@ -24,8 +32,99 @@ type PreSetToken struct {
 }
 
 type NamedToken struct {
-	Name      string `json:"name" clover:"name"`
-	Maximum   int    `json:"-" clover:"-"`
-	Available int    `json:"available" clover:"available"`
-	Level     int    `json:"level" clover:"level"`
+	Name         string `json:"name" clover:"name"`
+	Maximum      int    `json:"-" clover:"-"`
+	Available    int    `json:"available" clover:"available"`
+	Level        int    `json:"level" clover:"level"`
+	RecoveryType string `json:"-" clover:"-"`
+}
+
+// Token recovery types, tokens recovered on a short rest are also recovered on a long rest
+const (
+	TokenRecoveryShortRest string = "short-rest"
+	TokenRecoveryLongRest  string = "long-rest"
+)
+
+const (
+	CasterTypeFull  string = "full"
+	CasterTypeHalf  string = "half"
+	CasterTypeThird string = "third"
+)
+
+// Warlocks use Pact Magic rather than spellcasting slots, so they aren't included here
+var ClassCasterType = map[string]string{
+	ClassBard:     CasterTypeFull,
+	ClassCleric:   CasterTypeFull,
+	ClassDruid:    CasterTypeFull,
+	ClassSorcerer: CasterTypeFull,
+	ClassWizard:   CasterTypeFull,
+	ClassPaladin:  CasterTypeHalf,
+	ClassRanger:   CasterTypeHalf,
+}
+
+const (
+	SubClassEldritchKnight  string = "eldritch knight"
+	SubClassArcaneTrickster string = "arcane trickster"
+)
+
+// Subclasses that give a class without spellcasting its own spell slots
+var SubClassCasterType = map[string]string{
+	SubClassEldritchKnight:  CasterTypeThird,
+	SubClassArcaneTrickster: CasterTypeThird,
 }
+
+// Spell slots for slot levels 1-9 by caster level, indexed by caster level - 1. A single half caster class
+// uses half its class level rounded up, multiclassed half casters round down before adding to the total.
+// Third casters work the same way with a third of their class level
+var SpellSlotsByCasterLevel = [MaxLevel][9]int{
+	{2, 0, 0, 0, 0, 0, 0, 0, 0},
+	{3, 0, 0, 0, 0, 0, 0, 0, 0},
+	{4, 2, 0, 0, 0, 0, 0, 0, 0},
+	{4, 3, 0, 0, 0, 0, 0, 0, 0},
+	{4, 3, 2, 0, 0, 0, 0, 0, 0},
+	{4, 3, 3, 0, 0, 0, 0, 0, 0},
+	{4, 3, 3, 1, 0, 0, 0, 0, 0},
+	{4, 3, 3, 2, 0, 0, 0, 0, 0},
+	{4, 3, 3, 3, 1, 0, 0, 0, 0},
+	{4, 3, 3, 3, 2, 0, 0, 0, 0},
+	{4, 3, 3, 3, 2, 1, 0, 0, 0},
+	{4, 3, 3, 3, 2, 1, 0, 0, 0},
+	{4, 3, 3, 3, 2, 1, 1, 0, 0},
+	{4, 3, 3, 3, 2, 1, 1, 0, 0},
+	{4, 3, 3, 3, 2, 1, 1, 1, 0},
+	{4, 3, 3, 3, 2, 1, 1, 1, 0},
+	{4, 3, 3, 3, 2, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 2, 1, 1},
+}
+
+// Pact Magic slots by warlock level, indexed by warlock level - 1. Every pact slot is the same level
+var PactMagicSlots = [MaxLevel]int{1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 3, 3, 3, 3, 3, 4, 4, 4, 4}
+
+var PactMagicSlotLevel = [MaxLevel]int{1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5}
+
+const (
+	MetamagicCareful    string = "careful spell"
+	MetamagicDistant    string = "distant spell"
+	MetamagicEmpowered  string = "empowered spell"
+	MetamagicExtended   string = "extended spell"
+	MetamagicHeightened string = "heightened spell"
+	MetamagicQuickened  string = "quickened spell"
+	MetamagicSubtle     string = "subtle spell"
+	MetamagicTwinned    string = "twinned spell"
+)
+
+// Sorcery point cost of each metamagic option. Twinned spell costs the spell's level instead, 1 for cantrips
+var MetamagicCost = map[string]int{
+	MetamagicCareful:    1,
+	MetamagicDistant:    1,
+	MetamagicEmpowered:  1,
+	MetamagicExtended:   1,
+	MetamagicHeightened: 3,
+	MetamagicQuickened:  2,
+	MetamagicSubtle:     1,
+}
+
+// Sorcery points it takes to create a spell slot by slot level, slots above 5th level can't be created
+var SorceryPointSlotCost = map[int]int{1: 2, 2: 3, 3: 5, 4: 6, 5: 7}
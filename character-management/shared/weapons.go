@@ -2,7 +2,8 @@ package shared
 
 type Weapon struct {
 	Name        string      `json:"name" clover:"name"`
-	Bonus       int         `json:"-" clover:"-"`
+	AttackBonus int         `json:"-" clover:"-"`
+	DamageBonus int         `json:"-" clover:"-"`
 	CustomBonus int         `json:"custom-bonus" clover:"custom-bonus"`
 	Proficient  bool        `json:"proficient" clover:"proficient"`
 	Damage      string      `json:"damage" clover:"damage"`
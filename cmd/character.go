@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"bufio"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/onioncall/dndgo/character-management/handlers"
+	"github.com/onioncall/dndgo/character-management/models"
+	"github.com/onioncall/dndgo/character-management/shared"
 	"github.com/onioncall/dndgo/logger"
 
 	"github.com/spf13/cobra"
@@ -36,6 +39,10 @@ var (
 			n, _ := cmd.Flags().GetString("name")
 			sc, _ := cmd.Flags().GetString("sub-class")
 			ct, _ := cmd.Flags().GetString("class-type")
+			w, _ := cmd.Flags().GetFloat64("weight")
+			xp, _ := cmd.Flags().GetInt("xp")
+			f, _ := cmd.Flags().GetString("feat")
+			fd, _ := cmd.Flags().GetString("feat-description")
 
 			c, err := handlers.LoadCharacter()
 			if err != nil {
@@ -60,7 +67,11 @@ var (
 					return
 				}
 
-				c.AddEquipment(e, n)
+				if !cmd.Flags().Changed("weight") {
+					w = handlers.GetEquipmentWeight(n)
+				}
+
+				c.AddEquipment(e, n, w)
 			}
 			if bp != "" {
 				if q <= 0 {
@@ -68,20 +79,46 @@ var (
 					return
 				}
 
-				c.AddItemToPack(bp, q)
+				if !cmd.Flags().Changed("weight") {
+					w = handlers.GetEquipmentWeight(bp)
+				}
+
+				c.AddItemToPack(bp, q, w)
 			}
 			if il {
 				c.AddLevel()
 			}
 			if ss > 0 {
-				// add spell slot for level
+				q = max(q, 1) // If q isn't provided with a valid value, we add one slot by default
+				err = c.AddSpellSlotOverride(ss, q)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to override spell slots")
+					return
+				}
+
+				for _, divergence := range c.GetSpellSlotDivergences() {
+					fmt.Printf("Spell slots differ from your class levels, %s\n", divergence)
+				}
 			}
 			if s != "" {
-				err = handlers.AddSpell(c, s)
+				err = handlers.AddSpell(c, s, ct)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(err.Error())
+					return
+				}
 			}
 			if t != 0 {
 				c.AddTempHp(t)
 			}
+			if xp != 0 {
+				err = c.AddXP(xp)
+				if err != nil {
+					logger.PrintError(err.Error())
+					return
+				}
+			}
 			if sc != "" {
 				err = c.AddSubClass(ct, sc)
 				if err != nil {
@@ -97,6 +134,14 @@ var (
 					return
 				}
 			}
+			if f != "" {
+				name, ability, _ := strings.Cut(f, "/")
+				err = c.AddFeat(strings.TrimSpace(name), fd, strings.TrimSpace(ability))
+				if err != nil {
+					logger.PrintError(err.Error())
+					return
+				}
+			}
 
 			err = handlers.SaveCharacter(c)
 			if err != nil {
@@ -124,6 +169,14 @@ var (
 			}
 
 			logger.PrintSuccess("Character Update Successful")
+
+			if warning := c.GetLoadWarning(); warning != "" {
+				fmt.Println(warning)
+			}
+
+			if xp != 0 && c.IsLevelUpAvailable() {
+				fmt.Printf("%s has enough XP to reach level %d\n", c.Name, c.GetLevelFromXP())
+			}
 		},
 	}
 
@@ -240,6 +293,7 @@ var (
 		Run: func(cmd *cobra.Command, args []string) {
 			hp, _ := cmd.Flags().GetInt("hitpoints")
 			u, _ := cmd.Flags().GetInt("use-slot")
+			ss, _ := cmd.Flags().GetInt("spell-slots")
 
 			c, err := handlers.LoadCharacter()
 			if err != nil {
@@ -257,8 +311,23 @@ var (
 
 			if hp > 0 {
 				c.DamageCharacter(hp)
+
+				if status := c.DeathSaveStatus(); status != "" {
+					fmt.Println(status)
+				}
+
+				if c.ConcentrationSaveDC > 0 {
+					promptConcentrationSave(c)
+				}
 			} else if u > 0 {
 				c.UseSpellSlot(u)
+			} else if ss > 0 {
+				err = c.ClearSpellSlotOverride(ss)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to clear spell slot override")
+					return
+				}
 			}
 
 			err = handlers.SaveCharacter(c)
@@ -288,6 +357,12 @@ var (
 			d, _ := cmd.Flags().GetString("default-character-name")
 			n, _ := cmd.Flags().GetString("name")
 			sn, _ := cmd.Flags().GetString("short-name")
+			r, _ := cmd.Flags().GetString("race")
+			sr, _ := cmd.Flags().GetString("subrace")
+			rb, _ := cmd.Flags().GetString("racial-bonus")
+			ra, _ := cmd.Flags().GetString("racial-abilities")
+			rp, _ := cmd.Flags().GetString("replace-proficiency")
+			rpw, _ := cmd.Flags().GetString("replacement")
 
 			if d != "" {
 				err := handlers.SetDefaultCharacter(d)
@@ -316,6 +391,51 @@ var (
 				return
 			}
 
+			if r != "" || sr != "" {
+				if r == "" {
+					r = c.Race
+				}
+
+				err = c.SetRace(r, sr)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(err.Error())
+					return
+				}
+			}
+
+			if rb != "" {
+				abilities := []string{}
+				if ra != "" {
+					abilities = strings.Split(ra, ",")
+				}
+
+				err = c.SetRacialBonuses(rb, abilities)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(err.Error())
+					return
+				}
+			}
+
+			if rp != "" {
+				err = c.ReplaceBackgroundDuplicate(rp, rpw)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(err.Error())
+					return
+				}
+			}
+
+			if r != "" || rb != "" || rp != "" {
+				err = handlers.SaveCharacter(c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to save character data")
+					return
+				}
+			}
+
 			err = handlers.HandleCharacter(c)
 			if err != nil {
 				logger.Error(err)
@@ -341,6 +461,7 @@ var (
 		Short: "Use character items/spell slots",
 		Run: func(cmd *cobra.Command, args []string) {
 			s, _ := cmd.Flags().GetInt("spell-slots")
+			p, _ := cmd.Flags().GetBool("pact")
 			bp, _ := cmd.Flags().GetString("backpack")
 			q, _ := cmd.Flags().GetInt("quantity")
 			t, _ := cmd.Flags().GetString("class-tokens")
@@ -370,6 +491,13 @@ var (
 				logger.Error(err)
 				logger.PrintError("Failed to remove item from pack")
 				return
+			} else if p {
+				err = c.UsePactMagicSlot(s)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to use pact magic slot")
+					return
+				}
 			} else if s > 0 {
 				c.UseSpellSlot(s)
 			} else if t != "" {
@@ -412,6 +540,7 @@ var (
 		Run: func(cmd *cobra.Command, args []string) {
 			a, _ := cmd.Flags().GetBool("all")
 			ss, _ := cmd.Flags().GetInt("spell-slots")
+			p, _ := cmd.Flags().GetBool("pact")
 			hp, _ := cmd.Flags().GetInt("hitpoints")
 			t, _ := cmd.Flags().GetString("class-tokens")
 			ct, _ := cmd.Flags().GetString("class-type")
@@ -435,10 +564,20 @@ var (
 				c.Recover()
 			} else if ss > 0 {
 				c.RecoverSpellSlots(ss, q)
+			} else if p {
+				c.RecoverPactMagicSlots(q)
 			} else if hp > 0 {
 				c.HealCharacter(hp)
 			} else if t != "" {
-				c.RecoverClassTokens(t, ct, q)
+				// 'all' and 'any' recover every token for the class
+				if t == "all" || t == "any" {
+					t = ""
+				}
+
+				err = c.RecoverClassTokens(t, ct, q)
+				if err != nil {
+					logger.Error(err)
+				}
 			}
 
 			err = handlers.SaveCharacter(c)
@@ -476,6 +615,7 @@ var (
 		Run: func(cmd *cobra.Command, args []string) {
 			c, _ := cmd.Flags().GetStringSlice("class")
 			n, _ := cmd.Flags().GetString("name")
+			b, _ := cmd.Flags().GetString("background")
 
 			character, err := handlers.LoadCharacterTemplate(n, c)
 			if err != nil {
@@ -483,6 +623,7 @@ var (
 				logger.PrintError("Failed to load character template")
 				return
 			}
+			character.Background = b
 
 			err = handlers.CreateCharacter(character)
 			if err != nil {
@@ -491,6 +632,11 @@ var (
 				return
 			}
 
+			for _, duplicate := range character.BackgroundDuplicates {
+				fmt.Printf("Already proficient in '%s', choose a replacement with: dndgo ctr update --replace-proficiency '%s' --replacement <skill or tool>\n",
+					duplicate, duplicate)
+			}
+
 			logger.PrintError("Character Creation Successful")
 		},
 	}
@@ -595,14 +741,11 @@ var (
 		},
 	}
 
-	modifyCmd = &cobra.Command{
-		Use:   "modify",
-		Short: "modify character attributes",
+	attuneCmd = &cobra.Command{
+		Use:   "attune [item]",
+		Short: "Attune to a worn magic item or one in your backpack, up to three at a time",
+		Args:  cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			a, _ := cmd.Flags().GetString("ability-improvement")
-			q, _ := cmd.Flags().GetInt("quantity")
-			l, _ := cmd.Flags().GetInt("level")
-
 			c, err := handlers.LoadCharacter()
 			if err != nil {
 				logger.Error(err)
@@ -617,21 +760,15 @@ var (
 				return
 			}
 
-			if a != "" {
-				err = c.ModifyAbilityScoreImprovementItem(q, a)
-				if err != nil {
-					logger.Error(err)
-					logger.PrintError("Failed to modify ability score improvement item")
-					return
-				}
-			} else if l > 0 {
-				if l > 20 {
-					logger.PrintError("Level must be no more than 20")
-				}
-
-				c.SetLevel(l)
+			err = c.Attune(strings.Join(args, " "))
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError(err.Error())
+				return
 			}
 
+			fmt.Printf("Attuned to %s items\n", c.GetAttunement())
+
 			err = handlers.SaveCharacter(c)
 			if err != nil {
 				logger.Error(err)
@@ -652,107 +789,109 @@ var (
 		},
 	}
 
-	importCmd = &cobra.Command{
-		Use:   "import",
-		Short: "Imports a character or class, supports inserts and updates",
-		Long: `Imports a character or class from a json file 
-		Existing characters or classes can be exported via "export" command.
-		Will update existing record if ID is provided in json.`,
+	unattuneCmd = &cobra.Command{
+		Use:   "unattune [item]",
+		Short: "End attunement to a magic item",
+		Args:  cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			var entity string
-			classType, _ := cmd.Flags().GetString("class-type")
-			filePath, _ := cmd.Flags().GetString("file")
-			characterName, _ := cmd.Flags().GetString("character-name")
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
 
-			bytes, err := os.ReadFile(filePath)
+			err = handlers.HandleCharacter(c)
 			if err != nil {
-				logger.Errorf("Error reading file '%v':\n%v", filePath, err.Error())
-				logger.PrintError("Failed to import character")
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
 				return
 			}
 
-			if classType != "" {
-				classType = strings.ToLower(classType)
-				entity = "Class"
-				err = handlers.ImportClassJson(bytes, characterName, classType)
-				if err != nil {
-					logger.Error(err)
-					logger.PrintError("Failed to import class")
-					return
-				}
-			} else {
-				entity = "Character"
-				err = handlers.ImportCharacterJson(bytes)
+			err = c.Unattune(strings.Join(args, " "))
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError(err.Error())
+				return
+			}
+
+			fmt.Printf("Attuned to %s items\n", c.GetAttunement())
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
 				if err != nil {
 					logger.Error(err)
-					logger.PrintError("Failed to import character")
+					logger.PrintError("failed to generate markdown file")
 					return
 				}
 			}
 
-			logger.PrintSuccess(fmt.Sprintf("%v Import Successful", entity))
+			logger.PrintSuccess("Character Update Successful")
 		},
 	}
 
-	exportCmd = &cobra.Command{
-		Use:   "export",
-		Short: "Exports a character or class to a file",
-		Long: `Exports a character or class to a json file. 
-		Can be altered and re-imported with the "import" command.
-		Will update existing record if ID is provided in json.`,
+	attackCmd = &cobra.Command{
+		Use:   "attack",
+		Short: "Roll to hit and damage with a weapon",
 		Run: func(cmd *cobra.Command, args []string) {
-			var entity string
-			var data []byte
-			var err error
-			name, _ := cmd.Flags().GetString("name")
-			classType, _ := cmd.Flags().GetString("class-type")
-			filePath, _ := cmd.Flags().GetString("file")
+			s, _ := cmd.Flags().GetBool("secondary")
+			w, _ := cmd.Flags().GetString("weapon")
+			adv, _ := cmd.Flags().GetBool("adv")
+			dis, _ := cmd.Flags().GetBool("dis")
 
-			if classType != "" {
-				entity = "Class"
-				data, err = handlers.ExportClassJson(name, classType)
-				if err != nil {
-					logger.Error(err)
-					logger.PrintError("Failed to export class file")
-					return
-				}
-			} else {
-				entity = "Character"
-				data, err = handlers.ExportCharacterJson(name)
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			// Primary is the default when no weapon or hand is specified
+			if w == "" {
+				w, err = c.GetEquippedWeapon(!s)
 				if err != nil {
-					logger.Error(err)
-					logger.PrintError("Failed to export character file")
+					logger.PrintError(err.Error())
 					return
 				}
 			}
 
-			err = os.WriteFile(filePath, data, 0o644)
+			result, err := c.Attack(w, adv, dis)
 			if err != nil {
-				logger.PrintError(fmt.Sprintf("Failed to write file '%v'", filePath))
+				logger.Error(err)
+				logger.PrintError(err.Error())
 				return
 			}
 
-			logger.PrintSuccess(fmt.Sprintf("%v Export Successful", entity))
+			fmt.Println(result.String())
 		},
 	}
 
-	classCmd = &cobra.Command{
-		Use:   "class",
-		Short: "Executes commands on the class",
-		Long:  `Executes commands on the class via various flags.`,
+	restCmd = &cobra.Command{
+		Use:   "rest",
+		Short: "Take a short or long rest",
 		Run: func(cmd *cobra.Command, args []string) {
-			e, _ := cmd.Flags().GetString("expertise")
-			p, _ := cmd.Flags().GetString("prepared-spell")
-			o, _ := cmd.Flags().GetString("oath-spell")
-			f, _ := cmd.Flags().GetString("fighting-style")
-			v, _ := cmd.Flags().GetString("favored-enemy")
-			r, _ := cmd.Flags().GetBool("remove")
-			ct, _ := cmd.Flags().GetString("class-type")
+			l, _ := cmd.Flags().GetBool("long")
+			sp, _ := cmd.Flags().GetString("spend")
+			ar, _ := cmd.Flags().GetString("arcane-recovery")
 
 			c, err := handlers.LoadCharacter()
 			if err != nil {
 				logger.Error(err)
-				logger.PrintError("Failed to save character data")
+				logger.PrintError("Failed to load character data")
 				return
 			}
 
@@ -763,51 +902,791 @@ var (
 				return
 			}
 
-			if e != "" {
-				if r {
-					logger.PrintError("-> removing expertise skill is not implemented yet")
-					return
-				}
-
-				err := c.AddExpertiseSkill(e, ct)
+			if l {
+				c.Recover()
+			} else {
+				results, err := c.ShortRest(sp)
 				if err != nil {
 					logger.Error(err)
-					logger.PrintError("Failed to add expertise skill")
+					logger.PrintError(err.Error())
 					return
 				}
-			} else if p != "" {
-				if r {
-					err = c.RemovePreparedSpell(p, ct)
-					if err != nil {
-						logger.Error(err)
-						logger.PrintError("Failed to remove prepared spell")
-						return
-					}
-				} else {
-					err = c.AddPreparedSpell(p, ct)
-					if err != nil {
-						logger.Error(err)
-						logger.PrintError("Failed to add prepared spell")
-						return
-					}
+
+				for _, result := range results {
+					fmt.Println(result.String())
 				}
-			} else if o != "" {
-				if r {
-					err = c.RemoveOathSpell(o, ct)
-					if err != nil {
-						logger.Error(err)
-						logger.PrintError("Failed to remove oath spell")
-						return
-					}
-				} else {
-					err = c.AddOathSpell(o, ct)
+				fmt.Printf("HP: %d/%d\n", c.HPCurrent, c.HPMaxAdjusted)
+
+				if ar != "" {
+					err = c.ArcaneRecovery(ar)
 					if err != nil {
 						logger.Error(err)
-						logger.PrintError("Failed to add oath spell")
+						logger.PrintError(err.Error())
 						return
 					}
 				}
-			} else if f != "" {
+			}
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			for _, class := range c.Classes {
+				err = handlers.SaveClass(class)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(fmt.Sprintf("Failed to save data for class '%s'", class.GetClassType()))
+					return
+				}
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("failed to generate markdown file")
+					return
+				}
+			}
+
+			logger.PrintSuccess("Character Update Successful")
+		},
+	}
+
+	deathSaveCmd = &cobra.Command{
+		Use:   "death-save",
+		Short: "Roll a death saving throw for a dying character",
+		Run: func(cmd *cobra.Command, args []string) {
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			result, err := c.RollDeathSave()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError(err.Error())
+				return
+			}
+
+			fmt.Println(result.String())
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("failed to generate markdown file")
+					return
+				}
+			}
+
+			logger.PrintSuccess("Character Update Successful")
+		},
+	}
+
+	castCmd = &cobra.Command{
+		Use:   "cast [spell]",
+		Short: "Cast a known spell, using a spell slot and tracking concentration",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			l, _ := cmd.Flags().GetInt("level")
+			p, _ := cmd.Flags().GetBool("pact")
+			mm, _ := cmd.Flags().GetString("metamagic")
+
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			dropped, err := handlers.CastSpell(c, strings.Join(args, " "), l, p, mm)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError(err.Error())
+				return
+			}
+
+			if mm != "" {
+				fmt.Printf("Cast with %s, %d sorcery points left\n", mm, c.GetSorceryPoints())
+			}
+
+			if dropped != "" {
+				fmt.Printf("Concentration on %s ended\n", dropped)
+			}
+
+			if c.Concentration != "" {
+				fmt.Printf("Concentrating on %s\n", c.Concentration)
+			}
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			// Pact magic slots and sorcery points are saved with the class
+			for _, class := range c.Classes {
+				err = handlers.SaveClass(class)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(fmt.Sprintf("Failed to save data for class '%s'", class.GetClassType()))
+					return
+				}
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("failed to generate markdown file")
+					return
+				}
+			}
+
+			logger.PrintSuccess("Character Update Successful")
+		},
+	}
+
+	concentrationCmd = &cobra.Command{
+		Use:   "concentration",
+		Short: "Roll a concentration save or stop concentrating on a spell",
+		Run: func(cmd *cobra.Command, args []string) {
+			dc, _ := cmd.Flags().GetInt("save")
+			d, _ := cmd.Flags().GetBool("drop")
+
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			if d {
+				c.DropConcentration()
+			} else if cmd.Flags().Changed("save") {
+				result, err := c.RollConcentrationSave(dc)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(err.Error())
+					return
+				}
+
+				fmt.Println(result.String())
+			} else {
+				switch {
+				case c.Concentration == "":
+					fmt.Println("Not concentrating on a spell")
+				case c.ConcentrationSaveDC > 0:
+					fmt.Printf("Concentrating on %s, constitution save DC %d to roll\n", c.Concentration, c.ConcentrationSaveDC)
+				default:
+					fmt.Printf("Concentrating on %s\n", c.Concentration)
+				}
+				return
+			}
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("failed to generate markdown file")
+					return
+				}
+			}
+
+			logger.PrintSuccess("Character Update Successful")
+		},
+	}
+
+	sorceryCmd = &cobra.Command{
+		Use:   "sorcery",
+		Short: "Create a spell slot from sorcery points, or turn a spell slot into sorcery points",
+		Run: func(cmd *cobra.Command, args []string) {
+			cs, _ := cmd.Flags().GetInt("create-slot")
+			ss, _ := cmd.Flags().GetInt("convert-slot")
+
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			if cmd.Flags().Changed("create-slot") {
+				err = c.CreateSpellSlot(cs)
+			} else {
+				err = c.ConvertSpellSlot(ss)
+			}
+
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError(err.Error())
+				return
+			}
+
+			fmt.Printf("%d sorcery points left\n", c.GetSorceryPoints())
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			for _, class := range c.Classes {
+				err = handlers.SaveClass(class)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(fmt.Sprintf("Failed to save data for class '%s'", class.GetClassType()))
+					return
+				}
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("failed to generate markdown file")
+					return
+				}
+			}
+
+			logger.PrintSuccess("Character Update Successful")
+		},
+	}
+
+	levelUpCmd = &cobra.Command{
+		Use:   "level-up",
+		Short: "Level up a class, multiclassing into it if your character doesn't have it yet",
+		Run: func(cmd *cobra.Command, args []string) {
+			ct, _ := cmd.Flags().GetString("class-type")
+			r, _ := cmd.Flags().GetBool("roll")
+			asi, _ := cmd.Flags().GetString("asi")
+			f, _ := cmd.Flags().GetString("feat")
+			sc, _ := cmd.Flags().GetString("sub-class")
+
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			if ct == "" {
+				if len(c.ClassTypes) != 1 {
+					logger.PrintError("Class type is required for multi-class characters")
+					return
+				}
+
+				ct = c.ClassTypes[0]
+			}
+
+			result, err := handlers.LevelUp(c, ct, r)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError(err.Error())
+				return
+			}
+
+			fmt.Println(result.String())
+
+			if result.AbilityScoreImprovement {
+				err = chooseAbilityScoreImprovement(c, asi, f)
+				if err != nil {
+					logger.PrintError(err.Error())
+					fmt.Println("Choose later with: dndgo ctr add -a <ability> -q <bonus>")
+				}
+			}
+
+			if result.SubClassChoice {
+				if sc == "" {
+					sc = promptLine(fmt.Sprintf("Subclass for %s (leave empty to choose later): ", result.ClassType))
+				}
+
+				if sc != "" {
+					err = c.AddSubClass(result.ClassType, sc)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to add subclass")
+						return
+					}
+				}
+			}
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			for _, class := range c.Classes {
+				err = handlers.SaveClass(class)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(fmt.Sprintf("Failed to save data for class '%s'", class.GetClassType()))
+					return
+				}
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("failed to generate markdown file")
+					return
+				}
+			}
+
+			logger.PrintSuccess("Character Update Successful")
+		},
+	}
+
+	encumbranceCmd = &cobra.Command{
+		Use:   "encumbrance",
+		Short: "Show how much your character is carrying against their carrying capacity",
+		Run: func(cmd *cobra.Command, args []string) {
+			v, _ := cmd.Flags().GetBool("variant")
+
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			if cmd.Flags().Changed("variant") {
+				c.SetVariantEncumbrance(v)
+
+				err = handlers.SaveCharacter(c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to save character data")
+					return
+				}
+
+				if buildMd {
+					err = handlers.BuildCharacterMarkdown(*c)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("failed to generate markdown file")
+						return
+					}
+				}
+			}
+
+			fmt.Printf("Load: %s\nSpeed: %d\n", c.GetLoad(), c.SpeedAdjusted)
+			if warning := c.GetLoadWarning(); warning != "" {
+				fmt.Println(warning)
+			}
+		},
+	}
+
+	purseCmd = &cobra.Command{
+		Use:   "purse",
+		Short: "Add, spend, or convert money in your purse",
+		Run: func(cmd *cobra.Command, args []string) {
+			a, _ := cmd.Flags().GetString("add")
+			sp, _ := cmd.Flags().GetString("spend")
+			cv, _ := cmd.Flags().GetString("convert")
+			to, _ := cmd.Flags().GetString("to")
+
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			if a == "" && sp == "" && cv == "" {
+				fmt.Printf("Purse: %s\n", models.FormatCurrency(c.Purse))
+				return
+			}
+
+			err = updatePurse(c, a, sp, cv, to)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError(err.Error())
+				return
+			}
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("failed to generate markdown file")
+					return
+				}
+			}
+
+			fmt.Printf("Purse: %s\n", models.FormatCurrency(c.Purse))
+			logger.PrintSuccess("Character Update Successful")
+		},
+	}
+
+	conditionCmd = &cobra.Command{
+		Use:   "condition",
+		Short: "Apply or clear conditions and exhaustion",
+		Run: func(cmd *cobra.Command, args []string) {
+			a, _ := cmd.Flags().GetString("add")
+			r, _ := cmd.Flags().GetString("remove")
+			e, _ := cmd.Flags().GetInt("exhaustion")
+			cl, _ := cmd.Flags().GetBool("clear")
+
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			if a != "" {
+				err = c.AddCondition(a)
+			} else if r != "" {
+				err = c.RemoveCondition(r)
+			} else if cmd.Flags().Changed("exhaustion") {
+				err = c.SetExhaustion(e)
+			} else if cl {
+				c.ClearConditions()
+			}
+
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError(err.Error())
+				return
+			}
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("failed to generate markdown file")
+					return
+				}
+			}
+
+			if conditions := c.GetConditions(); len(conditions) > 0 {
+				fmt.Printf("Conditions: %s\n", strings.Join(conditions, ", "))
+			}
+
+			logger.PrintSuccess("Character Update Successful")
+		},
+	}
+
+	modifyCmd = &cobra.Command{
+		Use:   "modify",
+		Short: "modify character attributes",
+		Run: func(cmd *cobra.Command, args []string) {
+			a, _ := cmd.Flags().GetString("ability-improvement")
+			q, _ := cmd.Flags().GetInt("quantity")
+			l, _ := cmd.Flags().GetInt("level")
+			ms, _ := cmd.Flags().GetBool("milestone")
+
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to load character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			if a != "" {
+				err = c.ModifyAbilityScoreImprovementItem(q, a)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to modify ability score improvement item")
+					return
+				}
+			} else if l > 0 {
+				if l > 20 {
+					logger.PrintError("Level must be no more than 20")
+				}
+
+				c.SetLevel(l)
+			}
+
+			if cmd.Flags().Changed("milestone") {
+				c.MilestoneLeveling = ms
+			}
+
+			err = handlers.SaveCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			if buildMd {
+				err = handlers.BuildCharacterMarkdown(*c)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("failed to generate markdown file")
+					return
+				}
+			}
+
+			logger.PrintSuccess("Character Update Successful")
+		},
+	}
+
+	importCmd = &cobra.Command{
+		Use:   "import",
+		Short: "Imports a character or class, supports inserts and updates",
+		Long: `Imports a character or class from a json file 
+		Existing characters or classes can be exported via "export" command.
+		Will update existing record if ID is provided in json.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			var entity string
+			classType, _ := cmd.Flags().GetString("class-type")
+			filePath, _ := cmd.Flags().GetString("file")
+			characterName, _ := cmd.Flags().GetString("character-name")
+
+			bytes, err := os.ReadFile(filePath)
+			if err != nil {
+				logger.Errorf("Error reading file '%v':\n%v", filePath, err.Error())
+				logger.PrintError("Failed to import character")
+				return
+			}
+
+			if classType != "" {
+				classType = strings.ToLower(classType)
+				entity = "Class"
+				err = handlers.ImportClassJson(bytes, characterName, classType)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to import class")
+					return
+				}
+			} else {
+				entity = "Character"
+				err = handlers.ImportCharacterJson(bytes)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to import character")
+					return
+				}
+			}
+
+			logger.PrintSuccess(fmt.Sprintf("%v Import Successful", entity))
+		},
+	}
+
+	exportCmd = &cobra.Command{
+		Use:   "export",
+		Short: "Exports a character or class to a file",
+		Long: `Exports a character or class to a json file. 
+		Can be altered and re-imported with the "import" command.
+		Will update existing record if ID is provided in json.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			var entity string
+			var data []byte
+			var err error
+			name, _ := cmd.Flags().GetString("name")
+			classType, _ := cmd.Flags().GetString("class-type")
+			filePath, _ := cmd.Flags().GetString("file")
+
+			if classType != "" {
+				entity = "Class"
+				data, err = handlers.ExportClassJson(name, classType)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to export class file")
+					return
+				}
+			} else {
+				entity = "Character"
+				data, err = handlers.ExportCharacterJson(name)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to export character file")
+					return
+				}
+			}
+
+			err = os.WriteFile(filePath, data, 0o644)
+			if err != nil {
+				logger.PrintError(fmt.Sprintf("Failed to write file '%v'", filePath))
+				return
+			}
+
+			logger.PrintSuccess(fmt.Sprintf("%v Export Successful", entity))
+		},
+	}
+
+	classCmd = &cobra.Command{
+		Use:   "class",
+		Short: "Executes commands on the class",
+		Long:  `Executes commands on the class via various flags.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			e, _ := cmd.Flags().GetString("expertise")
+			p, _ := cmd.Flags().GetString("prepared-spell")
+			o, _ := cmd.Flags().GetString("oath-spell")
+			f, _ := cmd.Flags().GetString("fighting-style")
+			v, _ := cmd.Flags().GetString("favored-enemy")
+			i, _ := cmd.Flags().GetString("invocation")
+			b, _ := cmd.Flags().GetString("pact-boon")
+			k, _ := cmd.Flags().GetString("spellbook")
+			w, _ := cmd.Flags().GetString("swap-spell")
+			ns, _ := cmd.Flags().GetString("new-spell")
+			ls, _ := cmd.Flags().GetString("learned-spell")
+			r, _ := cmd.Flags().GetBool("remove")
+			ct, _ := cmd.Flags().GetString("class-type")
+
+			c, err := handlers.LoadCharacter()
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to save character data")
+				return
+			}
+
+			err = handlers.HandleCharacter(c)
+			if err != nil {
+				logger.Error(err)
+				logger.PrintError("Failed to process character")
+				return
+			}
+
+			if e != "" {
+				if r {
+					logger.PrintError("-> removing expertise skill is not implemented yet")
+					return
+				}
+
+				err := c.AddExpertiseSkill(e, ct)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError("Failed to add expertise skill")
+					return
+				}
+			} else if p != "" {
+				if r {
+					err = c.RemovePreparedSpell(p, ct)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to remove prepared spell")
+						return
+					}
+				} else {
+					err = c.AddPreparedSpell(p, ct)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to add prepared spell")
+						return
+					}
+				}
+			} else if o != "" {
+				if r {
+					err = c.RemoveOathSpell(o, ct)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to remove oath spell")
+						return
+					}
+				} else {
+					err = c.AddOathSpell(o, ct)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to add oath spell")
+						return
+					}
+				}
+			} else if f != "" {
 				if r {
 					logger.PrintError("-> removing fighting style is not implemented")
 					return
@@ -835,6 +1714,74 @@ var (
 						return
 					}
 				}
+			} else if i != "" {
+				if r {
+					err = c.RemoveInvocation(i, ct)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to remove invocation")
+						return
+					}
+				} else {
+					err = c.AddInvocation(i, ct)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to add invocation")
+						return
+					}
+				}
+			} else if b != "" {
+				if r {
+					logger.PrintError("-> removing pact boon is not implemented")
+					return
+				} else {
+					err = c.SetPactBoon(b, ct)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to set pact boon")
+						return
+					}
+				}
+			} else if k != "" {
+				if r {
+					err = c.RemoveSpellbookSpell(k, ct)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to remove spell from spellbook")
+						return
+					}
+				} else {
+					err = c.AddSpellbookSpell(k, ct)
+					if err != nil {
+						logger.Error(err)
+						logger.PrintError("Failed to add spell to spellbook")
+						return
+					}
+				}
+			} else if w != "" {
+				if r {
+					logger.PrintError("-> removing a spell swap does not apply")
+					return
+				}
+
+				err = handlers.SwapSpell(c, w, ns, ct)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(err.Error())
+					return
+				}
+			} else if ls != "" {
+				if r {
+					logger.PrintError("-> removing the class a spell was learned through does not apply")
+					return
+				}
+
+				err = c.SetSpellClass(ls, ct)
+				if err != nil {
+					logger.Error(err)
+					logger.PrintError(err.Error())
+					return
+				}
 			}
 
 			for _, class := range c.Classes {
@@ -871,10 +1818,22 @@ func init() {
 		deleteCmd,
 		equipCmd,
 		unequipCmd,
+		attuneCmd,
+		unattuneCmd,
 		modifyCmd,
 		importCmd,
 		exportCmd,
-		classCmd)
+		classCmd,
+		attackCmd,
+		restCmd,
+		deathSaveCmd,
+		conditionCmd,
+		castCmd,
+		concentrationCmd,
+		sorceryCmd,
+		purseCmd,
+		encumbranceCmd,
+		levelUpCmd)
 
 	characterCmd.Flags().BoolVar(&buildMd, "build-md", false, "generate markdown file")
 
@@ -883,7 +1842,7 @@ func init() {
 	addCmd.Flags().BoolP("level", "l", false, "Level to add")
 	addCmd.Flags().StringP("language", "", "", "Language to add")
 	addCmd.Flags().StringP("weapon", "w", "", "Weapon to add")
-	addCmd.Flags().IntP("spell-slots", "s", 0, "Increase spell-slot max capacity by level")
+	addCmd.Flags().IntP("spell-slots", "s", 0, "Manually increase spell-slot max capacity by level, overriding the class tables (use -q to specify quantity)")
 	addCmd.Flags().StringP("spell", "x", "", "Add spell to list of character spells")
 	addCmd.Flags().StringP("backpack", "b", "", "Item to add to backpack (use -q to specify quantity)")
 	addCmd.Flags().IntP("quantity", "q", 0, "Modify quantity of something")
@@ -891,19 +1850,35 @@ func init() {
 	addCmd.Flags().StringP("name", "n", "", "Name of equipment to add")
 	addCmd.Flags().StringP("sub-class", "u", "", "Name of sub-class to add")
 	addCmd.Flags().StringP("class-type", "c", "", "class type to modify (only required for multi-class)")
+	addCmd.Flags().Int("xp", 0, "Experience points to add")
+	addCmd.Flags().Float64("weight", 0, "Weight in pounds of the backpack item or equipment (looked up when not set)")
+	addCmd.Flags().String("feat", "", "Feat gained outside of an ability score improvement, ex: tough or resilient/constitution")
+	addCmd.Flags().String("feat-description", "", "Description of a homebrew feat")
+
+	levelUpCmd.Flags().StringP("class-type", "c", "", "class to level up, a class your character doesn't have multiclasses into it (only required for multi-class)")
+	levelUpCmd.Flags().BoolP("roll", "r", false, "roll the hit die for hp instead of taking the average")
+	levelUpCmd.Flags().StringP("asi", "a", "", "ability score improvement, one ability for +2 or two comma separated abilities for +1 each")
+	levelUpCmd.Flags().StringP("feat", "f", "", "feat to take instead of an ability score improvement, ex: alert or resilient/constitution")
+	levelUpCmd.Flags().StringP("sub-class", "u", "", "subclass to choose when reaching your class's subclass level")
+	levelUpCmd.MarkFlagsMutuallyExclusive("asi", "feat")
+
+	encumbranceCmd.Flags().Bool("variant", false, "Use the variant encumbrance rules, --variant=false to turn them off")
 
 	removeCmd.Flags().StringP("language", "l", "", "Language to remove")
 	removeCmd.Flags().StringP("weapon", "w", "", "Weapon to remove")
 	removeCmd.Flags().StringP("backpack", "b", "", "Item to remove from backpack")
 	removeCmd.Flags().IntP("hitpoints", "p", 0, "Include or modify hitpoints")
+	removeCmd.Flags().IntP("spell-slots", "s", 0, "Clear a manual spell-slot override by level")
 
-	useCmd.Flags().IntP("spell-slots", "s", 0, "Use spell-slot by level")
+	useCmd.Flags().IntP("spell-slots", "s", 0, "Use spell-slot by level, pact magic slots are used once spell slots for the level run out")
+	useCmd.Flags().Bool("pact", false, "Use a pact magic slot instead of a spell slot")
 	useCmd.Flags().StringP("backpack", "b", "", "Use item from backpack")
 	useCmd.Flags().IntP("quantity", "q", 0, "Modify quantity of something")
 	useCmd.Flags().StringP("class-tokens", "t", "any", "Use class-tokens by token name")
 	useCmd.Flags().StringP("class-type", "c", "", "class type to modify (only required for multi-class)")
 
 	recoverCmd.Flags().IntP("spell-slots", "s", 0, "recover spell-slot by level")
+	recoverCmd.Flags().Bool("pact", false, "recover pact magic slots")
 	recoverCmd.Flags().BoolP("all", "a", false, "recover all health, slots, and tokens")
 	recoverCmd.Flags().IntP("hitpoints", "p", 0, "recover hitpoints")
 	recoverCmd.Flags().StringP("class-tokens", "t", "all", "recover class-tokens by token name")
@@ -912,6 +1887,7 @@ func init() {
 
 	initCmd.Flags().StringP("class", "c", "", "name of character class")
 	initCmd.Flags().StringP("name", "n", "", "name of character")
+	initCmd.Flags().StringP("background", "b", "", "background of character, ex: acolyte")
 	initCmd.MarkFlagRequired("class")
 	initCmd.MarkFlagRequired("name")
 
@@ -921,6 +1897,49 @@ func init() {
 	unequipCmd.Flags().BoolP("primary", "p", false, "equip primary weapon or shield")
 	unequipCmd.Flags().BoolP("secondary", "s", false, "equip secondary weapon or shield")
 
+	attackCmd.Flags().BoolP("primary", "p", false, "attack with primary weapon (default)")
+	attackCmd.Flags().BoolP("secondary", "s", false, "attack with secondary weapon")
+	attackCmd.Flags().StringP("weapon", "w", "", "name of weapon to attack with")
+	attackCmd.Flags().Bool("adv", false, "roll to hit with advantage")
+	attackCmd.Flags().Bool("dis", false, "roll to hit with disadvantage")
+	attackCmd.MarkFlagsMutuallyExclusive("primary", "secondary", "weapon")
+
+	restCmd.Flags().BoolP("short", "s", false, "take a short rest, recovering short rest class tokens")
+	restCmd.Flags().BoolP("long", "l", false, "take a long rest, recovering health, slots, tokens, and half of your hit dice")
+	restCmd.Flags().StringP("spend", "d", "", "hit dice to spend on a short rest, ex: 2d10 or 1d10+1d8")
+	restCmd.MarkFlagsMutuallyExclusive("short", "long")
+	restCmd.Flags().StringP("arcane-recovery", "a", "", "spell slot levels to recover with arcane recovery on a short rest, ex: 2 or 1,1")
+	restCmd.MarkFlagsMutuallyExclusive("long", "spend")
+	restCmd.MarkFlagsMutuallyExclusive("long", "arcane-recovery")
+	restCmd.MarkFlagsOneRequired("short", "long")
+
+	castCmd.Flags().IntP("level", "l", 0, "spell slot level to cast with (default: the spell's level)")
+	castCmd.Flags().Bool("pact", false, "cast with a pact magic slot, which is always cast at the pact slot level")
+	castCmd.Flags().StringP("metamagic", "m", "", "metamagic option to cast with, its sorcery point cost is spent")
+
+	sorceryCmd.Flags().IntP("create-slot", "c", 0, "spell slot level to create from sorcery points (1-5)")
+	sorceryCmd.Flags().IntP("convert-slot", "s", 0, "spell slot level to expend for sorcery points")
+	sorceryCmd.MarkFlagsMutuallyExclusive("create-slot", "convert-slot")
+	sorceryCmd.MarkFlagsOneRequired("create-slot", "convert-slot")
+
+	concentrationCmd.Flags().IntP("save", "s", 0, "roll a constitution save against the given dc to keep concentrating (0 uses the dc from the last damage taken)")
+	concentrationCmd.Flags().BoolP("drop", "d", false, "stop concentrating on the current spell")
+	concentrationCmd.MarkFlagsMutuallyExclusive("save", "drop")
+
+	purseCmd.Flags().StringP("add", "a", "", "money to add, ex: 15gp or '3gp 5sp'")
+	purseCmd.Flags().StringP("spend", "s", "", "money to spend, change is made automatically, ex: 2gp")
+	purseCmd.Flags().StringP("convert", "c", "", "coins to convert, ex: 20sp (use --to for the denomination)")
+	purseCmd.Flags().StringP("to", "t", "", "denomination to convert to (cp, sp, ep, gp, pp)")
+	purseCmd.MarkFlagsMutuallyExclusive("add", "spend", "convert")
+	purseCmd.MarkFlagsRequiredTogether("convert", "to")
+
+	conditionCmd.Flags().StringP("add", "a", "", "condition to apply (poisoned, prone, restrained, frightened, exhaustion)")
+	conditionCmd.Flags().StringP("remove", "r", "", "condition to remove, removing exhaustion lowers the level by one")
+	conditionCmd.Flags().IntP("exhaustion", "e", 0, "set exhaustion level (0-6)")
+	conditionCmd.Flags().Bool("clear", false, "remove all conditions and exhaustion")
+	conditionCmd.MarkFlagsMutuallyExclusive("add", "remove", "exhaustion", "clear")
+	conditionCmd.MarkFlagsOneRequired("add", "remove", "exhaustion", "clear")
+
 	getCmd.Flags().StringP("path", "p", "", "get config or markdown path")
 	getCmd.Flags().BoolP("tokens", "t", false, "get class tokens")
 	getCmd.Flags().BoolP("character-names", "n", false, "get character names")
@@ -931,6 +1950,14 @@ func init() {
 	updateCmd.Flags().StringP("default-character-name", "d", "", "name of character to make default")
 	updateCmd.Flags().StringP("short-name", "s", "", "short name of character to update")
 	updateCmd.Flags().StringP("name", "n", "", "full name of character")
+	updateCmd.Flags().StringP("race", "r", "", "race of character, ex: dwarf, half-elf")
+	updateCmd.Flags().String("subrace", "", "subrace of character, ex: hill, wood")
+	updateCmd.Flags().StringP("racial-bonus", "b", "", "racial ability bonus mode, fixed or flexible")
+	updateCmd.Flags().StringP("racial-abilities", "a", "", "comma separated abilities for racial bonuses, flexible gives the first +2 and second +1")
+	updateCmd.Flags().String("replace-proficiency", "", "proficiency granted twice by your background, use with replacement")
+	updateCmd.Flags().String("replacement", "", "skill or tool proficiency to take instead of the duplicate")
+	updateCmd.MarkFlagsRequiredTogether("racial-abilities", "racial-bonus")
+	updateCmd.MarkFlagsRequiredTogether("replace-proficiency", "replacement")
 
 	importCmd.Flags().StringP("class-type", "c", "", "class type for class file import (default: character)")
 	importCmd.Flags().StringP("file", "f", "", "relative path to json file")
@@ -947,12 +1974,123 @@ func init() {
 
 	modifyCmd.Flags().StringP("ability-improvement", "a", "", "Ability Score Improvement item name, (use -q to specify a quantity)")
 	modifyCmd.Flags().IntP("quantity", "q", 0, "Modify quantity of something")
+	modifyCmd.Flags().Bool("milestone", false, "Level by milestone instead of XP, --milestone=false to track XP again")
 
 	classCmd.Flags().StringP("expertise", "e", "", "name of skill to add to expertise")
 	classCmd.Flags().StringP("prepared-spell", "p", "", "name of spell to prepare")
 	classCmd.Flags().StringP("fighting-style", "f", "", "name of fighting style to assign")
 	classCmd.Flags().StringP("favored-enemy", "v", "", "name of favored to assign")
 	classCmd.Flags().StringP("oath-spell", "o", "", "name of oath spell to add")
+	classCmd.Flags().StringP("invocation", "i", "", "name of eldritch invocation to add")
+	classCmd.Flags().StringP("pact-boon", "b", "", "name of pact boon to choose (remove does not apply)")
+	classCmd.Flags().StringP("spellbook", "k", "", "name of spell to copy into spellbook")
+	classCmd.Flags().StringP("swap-spell", "w", "", "name of known spell to replace after leveling up (use with --new-spell)")
+	classCmd.Flags().String("new-spell", "", "name of spell to learn in place of the swapped spell")
+	classCmd.Flags().StringP("learned-spell", "l", "", "name of known spell learned through this class, so it uses the class's save DC and attack modifier")
+	classCmd.MarkFlagsRequiredTogether("swap-spell", "new-spell")
 	classCmd.Flags().BoolP("remove", "r", false, "remove instead of add one of these things")
 	classCmd.Flags().StringP("class-type", "c", "", "class type to modify (only required for multi-class)")
 }
+
+func promptLine(prompt string) string {
+	fmt.Print(prompt)
+
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	return strings.TrimSpace(answer)
+}
+
+// An ability score improvement is either one ability (+2), two comma separated abilities (+1 each), or a feat.
+// When nothing was passed in we ask for it, an empty answer leaves the choice for later
+func chooseAbilityScoreImprovement(c *models.Character, asi string, feat string) error {
+	if asi == "" && feat == "" {
+		answer := promptLine("Enter one ability for +2, two abilities separated by a comma for +1 each, " +
+			"or 'feat <name>/<ability>' (leave empty to choose later): ")
+
+		if name, isFeat := strings.CutPrefix(answer, "feat "); isFeat {
+			feat = strings.TrimSpace(name)
+		} else {
+			asi = answer
+		}
+	}
+
+	switch {
+	case feat != "":
+		name, ability, _ := strings.Cut(feat, "/")
+		return c.ChooseFeat(name, ability)
+	case asi != "":
+		return c.ApplyAbilityScoreImprovement(strings.Split(asi, ","))
+	}
+
+	return nil
+}
+
+// Offers to roll the constitution save right away after taking damage while concentrating
+func promptConcentrationSave(c *models.Character) {
+	// Scripts piping into dndgo can't answer, the save dc is kept so it can be rolled later
+	if !stdinIsTerminal() {
+		fmt.Printf("Concentrating on %s, constitution save DC %d. Roll with: dndgo ctr concentration --save 0\n",
+			c.Concentration, c.ConcentrationSaveDC)
+		return
+	}
+
+	fmt.Printf("Concentrating on %s, constitution save DC %d. Roll now? (y/n): ", c.Concentration, c.ConcentrationSaveDC)
+
+	var answer string
+	fmt.Scanln(&answer)
+
+	if !strings.EqualFold(answer, "y") && !strings.EqualFold(answer, "yes") {
+		fmt.Println("Roll later with: dndgo ctr concentration --save 0")
+		return
+	}
+
+	result, err := c.RollConcentrationSave(0)
+	if err != nil {
+		logger.Error(err)
+		logger.PrintError(err.Error())
+		return
+	}
+
+	fmt.Println(result.String())
+}
+
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func updatePurse(c *models.Character, add string, spend string, convert string, to string) error {
+	switch {
+	case add != "":
+		amount, err := models.ParseCurrency(add)
+		if err != nil {
+			return err
+		}
+
+		c.AddCurrency(amount)
+	case spend != "":
+		cost, err := models.ParseCurrency(spend)
+		if err != nil {
+			return err
+		}
+
+		return c.SpendCurrency(cost)
+	case convert != "":
+		coins, err := models.ParseCurrency(convert)
+		if err != nil {
+			return err
+		}
+
+		// Only a single denomination can be converted at a time
+		for _, denomination := range shared.Currencies {
+			if amount := models.GetCurrencyAmount(coins, denomination); amount > 0 {
+				return c.ConvertCurrency(amount, denomination, to)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onioncall/dndgo/dice"
+	"github.com/onioncall/dndgo/logger"
+	"github.com/spf13/cobra"
+)
+
+var rollCmd = &cobra.Command{
+	Use:   "roll [expression]",
+	Short: "Roll dice, ex: 2d6+3, '1d20+5 adv', 4d6kh3, '8d6 fire'",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		seed, _ := cmd.Flags().GetInt64("seed")
+
+		if cmd.Flags().Changed("seed") {
+			dice.SetSeed(seed)
+		}
+
+		result, err := dice.Roll(strings.Join(args, " "))
+		if err != nil {
+			logger.PrintError(err.Error())
+			return
+		}
+
+		fmt.Println(result.String())
+	},
+}
+
+func init() {
+	rollCmd.Flags().Int64("seed", 0, "Seed the dice roller to get repeatable results")
+}
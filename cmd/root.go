@@ -76,4 +76,5 @@ func init() {
 	rootCmd.AddCommand(characterCmd)
 	rootCmd.AddCommand(searchCmd)
 	rootCmd.AddCommand(tuiCmd)
+	rootCmd.AddCommand(rollCmd)
 }
@@ -0,0 +1,485 @@
+package dice
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Roller rolls dice expressions using its own random source, so a seeded roller will always produce
+// the same results in the same order
+type Roller struct {
+	rng *rand.Rand
+}
+
+type Die struct {
+	Value   int
+	Dropped bool
+}
+
+type TermResult struct {
+	Term  string
+	Dice  []Die
+	Total int
+}
+
+type Result struct {
+	Expression   string
+	Terms        []TermResult
+	Modifier     int
+	DamageType   string
+	Advantage    bool
+	Disadvantage bool
+	Total        int
+}
+
+// A single dice term of an expression like the '4d6kh3' in '4d6kh3+2'. Negative counts are
+// subtracted from the total
+type DiceTerm struct {
+	Count       int
+	Sides       int
+	Keep        int
+	KeepHighest bool
+}
+
+type Expression struct {
+	Raw          string
+	DiceTerms    []DiceTerm
+	Modifier     int
+	DamageType   string
+	Advantage    bool
+	Disadvantage bool
+}
+
+const (
+	AdvantageKeyword    string = "adv"
+	DisadvantageKeyword string = "dis"
+)
+
+// Expressions come straight from user input, these keep a typo from trying to roll billions of dice
+const (
+	MaxDice  int = 1000
+	MaxSides int = 1000
+)
+
+var defaultRoller = NewRoller(time.Now().UnixNano())
+
+func NewRoller(seed int64) *Roller {
+	return &Roller{
+		rng: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Seeds the package level roller, mostly useful for tests and reproducing a set of rolls
+func SetSeed(seed int64) {
+	defaultRoller = NewRoller(seed)
+}
+
+// Rolls an expression with the package level roller
+func Roll(expression string) (Result, error) {
+	return defaultRoller.Roll(expression)
+}
+
+// Rolls an expression with the package level roller, doubling the number of dice rolled
+func RollCritical(expression string) (Result, error) {
+	return defaultRoller.RollCritical(expression)
+}
+
+// Rolls an already parsed expression with the package level roller
+func RollExpression(e Expression) Result {
+	return defaultRoller.RollExpression(e)
+}
+
+// Rolls a single die with the package level roller
+func RollDie(sides int) int {
+	return defaultRoller.RollDie(sides)
+}
+
+func (r *Roller) RollDie(sides int) int {
+	if sides < 1 {
+		return 0
+	}
+
+	return r.rng.Intn(sides) + 1
+}
+
+func (r *Roller) Roll(expression string) (Result, error) {
+	e, err := Parse(expression)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return r.RollExpression(e), nil
+}
+
+// Critical hits roll all of the damage dice twice, modifiers are only added once
+func (r *Roller) RollCritical(expression string) (Result, error) {
+	e, err := Parse(expression)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return r.RollExpression(e.Critical()), nil
+}
+
+func (r *Roller) RollExpression(e Expression) Result {
+	result := Result{
+		Expression:   e.Raw,
+		Modifier:     e.Modifier,
+		DamageType:   e.DamageType,
+		Advantage:    e.Advantage,
+		Disadvantage: e.Disadvantage,
+	}
+
+	for _, term := range e.DiceTerms {
+		tr := r.rollTerm(term)
+		result.Terms = append(result.Terms, tr)
+		result.Total += tr.Total
+	}
+
+	result.Total += e.Modifier
+
+	return result
+}
+
+func (r *Roller) rollTerm(term DiceTerm) TermResult {
+	count := term.Count
+	sign := 1
+	if count < 0 {
+		count *= -1
+		sign = -1
+	}
+
+	tr := TermResult{
+		Term: term.String(),
+		Dice: make([]Die, count),
+	}
+
+	for i := range tr.Dice {
+		tr.Dice[i].Value = r.RollDie(term.Sides)
+	}
+
+	if term.Keep > 0 && term.Keep < count {
+		dropCount := count - term.Keep
+		for range dropCount {
+			dropIdx := -1
+			for i, d := range tr.Dice {
+				if d.Dropped {
+					continue
+				}
+
+				if dropIdx == -1 {
+					dropIdx = i
+					continue
+				}
+
+				// when keeping the highest dice, we drop the lowest ones and vice versa
+				if term.KeepHighest && d.Value < tr.Dice[dropIdx].Value {
+					dropIdx = i
+				} else if !term.KeepHighest && d.Value > tr.Dice[dropIdx].Value {
+					dropIdx = i
+				}
+			}
+
+			tr.Dice[dropIdx].Dropped = true
+		}
+	}
+
+	for _, d := range tr.Dice {
+		if !d.Dropped {
+			tr.Total += d.Value
+		}
+	}
+
+	tr.Total *= sign
+
+	return tr
+}
+
+// Parses expressions like '2d6+3', '1d20+5 adv', '4d6kh3' or '8d6 fire'. Anything after the dice
+// notation that isn't 'adv' or 'dis' is treated as the damage type
+func Parse(expression string) (Expression, error) {
+	e := Expression{
+		Raw: strings.TrimSpace(expression),
+	}
+
+	fields := strings.Fields(strings.ToLower(e.Raw))
+	if len(fields) == 0 {
+		return e, fmt.Errorf("Dice expression can not be empty")
+	}
+
+	// Dice notation is allowed to contain spaces around operators ('1d8 + 2'), so we gather
+	// everything up until the first word that isn't part of the notation
+	notation := ""
+	wordIdx := len(fields)
+	for i, field := range fields {
+		if i > 0 && !isNotation(field) && !strings.HasSuffix(notation, "+") && !strings.HasSuffix(notation, "-") {
+			wordIdx = i
+			break
+		}
+
+		notation += field
+	}
+
+	var damageType []string
+	for _, word := range fields[wordIdx:] {
+		switch word {
+		case AdvantageKeyword, "advantage":
+			e.Advantage = true
+		case DisadvantageKeyword, "disadvantage":
+			e.Disadvantage = true
+		default:
+			damageType = append(damageType, word)
+		}
+	}
+	e.DamageType = strings.Join(damageType, " ")
+
+	if e.Advantage && e.Disadvantage {
+		// Advantage and disadvantage cancel each other out
+		e.Advantage = false
+		e.Disadvantage = false
+	}
+
+	err := e.parseNotation(notation)
+	if err != nil {
+		return e, fmt.Errorf("Invalid dice expression '%s': %w", expression, err)
+	}
+
+	if e.Advantage || e.Disadvantage {
+		err = e.applyAdvantage()
+		if err != nil {
+			return e, fmt.Errorf("Invalid dice expression '%s': %w", expression, err)
+		}
+	}
+
+	return e, nil
+}
+
+func (e *Expression) parseNotation(notation string) error {
+	if notation == "" {
+		return fmt.Errorf("no dice notation found")
+	}
+
+	sign := 1
+	term := ""
+	for i, ch := range notation {
+		if ch == '+' || ch == '-' {
+			if term == "" && i != 0 {
+				return fmt.Errorf("operator without a value")
+			}
+
+			if term != "" {
+				if err := e.addTerm(term, sign); err != nil {
+					return err
+				}
+			}
+
+			term = ""
+			sign = 1
+			if ch == '-' {
+				sign = -1
+			}
+
+			continue
+		}
+
+		term += string(ch)
+	}
+
+	if term == "" {
+		return fmt.Errorf("expression can not end with an operator")
+	}
+
+	return e.addTerm(term, sign)
+}
+
+func (e *Expression) addTerm(term string, sign int) error {
+	if !strings.Contains(term, "d") {
+		mod, err := strconv.Atoi(term)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a number or dice term", term)
+		}
+
+		e.Modifier += mod * sign
+		return nil
+	}
+
+	countStr, rest, _ := strings.Cut(term, "d")
+	dt := DiceTerm{Count: 1}
+
+	if countStr != "" {
+		count, err := strconv.Atoi(countStr)
+		if err != nil || count < 1 {
+			return fmt.Errorf("'%s' is not a valid number of dice", countStr)
+		}
+		if count > MaxDice {
+			return fmt.Errorf("can't roll more than %d dice at once, %d given", MaxDice, count)
+		}
+		dt.Count = count
+	}
+
+	sidesStr := rest
+	keepStr := ""
+	if idx := strings.Index(rest, "k"); idx != -1 {
+		sidesStr = rest[:idx]
+		keepStr = rest[idx+1:]
+	}
+
+	sides, err := strconv.Atoi(sidesStr)
+	if err != nil || sides < 1 {
+		return fmt.Errorf("'%s' is not a valid number of sides", sidesStr)
+	}
+	if sides > MaxSides {
+		return fmt.Errorf("dice can't have more than %d sides, %d given", MaxSides, sides)
+	}
+	dt.Sides = sides
+
+	if keepStr != "" {
+		dt.KeepHighest = true
+		switch keepStr[0] {
+		case 'h':
+			keepStr = keepStr[1:]
+		case 'l':
+			dt.KeepHighest = false
+			keepStr = keepStr[1:]
+		}
+
+		keep, err := strconv.Atoi(keepStr)
+		if err != nil || keep < 1 || keep > dt.Count {
+			return fmt.Errorf("'%s' is not a valid number of dice to keep", keepStr)
+		}
+		dt.Keep = keep
+	}
+
+	dt.Count *= sign
+	e.DiceTerms = append(e.DiceTerms, dt)
+
+	return nil
+}
+
+// Returns a copy of the expression with the number of dice doubled, leaving the modifier alone
+func (e Expression) Critical() Expression {
+	terms := make([]DiceTerm, len(e.DiceTerms))
+	copy(terms, e.DiceTerms)
+
+	for i := range terms {
+		terms[i].Count *= 2
+		terms[i].Keep *= 2
+	}
+
+	e.DiceTerms = terms
+	return e
+}
+
+// Advantage and disadvantage roll each d20 twice and keep the higher or lower roll
+func (e *Expression) applyAdvantage() error {
+	for i, term := range e.DiceTerms {
+		if term.Sides != 20 || term.Keep > 0 {
+			continue
+		}
+
+		e.DiceTerms[i].Keep = term.Count
+		e.DiceTerms[i].Count *= 2
+		e.DiceTerms[i].KeepHighest = e.Advantage
+		return nil
+	}
+
+	return fmt.Errorf("advantage and disadvantage require a d20")
+}
+
+// Returns the value of the first kept d20 in the roll, or 0 if no d20 was rolled. Used to
+// check for natural 20s and 1s
+func (r Result) Natural() int {
+	for _, term := range r.Terms {
+		if !strings.HasSuffix(strings.Split(term.Term, "k")[0], "d20") {
+			continue
+		}
+
+		for _, d := range term.Dice {
+			if !d.Dropped {
+				return d.Value
+			}
+		}
+	}
+
+	return 0
+}
+
+func (r Result) String() string {
+	var s string
+
+	s += fmt.Sprintf("%s: ", r.Expression)
+	for i, term := range r.Terms {
+		if i > 0 {
+			s += " "
+		}
+
+		rolls := make([]string, len(term.Dice))
+		for j, d := range term.Dice {
+			rolls[j] = strconv.Itoa(d.Value)
+			if d.Dropped {
+				rolls[j] = fmt.Sprintf("~%d~", d.Value)
+			}
+		}
+
+		s += fmt.Sprintf("[%s]", strings.Join(rolls, ", "))
+	}
+
+	if r.Modifier > 0 {
+		s += fmt.Sprintf(" +%d", r.Modifier)
+	} else if r.Modifier < 0 {
+		s += fmt.Sprintf(" %d", r.Modifier)
+	}
+
+	s += fmt.Sprintf(" = %d", r.Total)
+
+	if r.DamageType != "" {
+		s += fmt.Sprintf(" %s", r.DamageType)
+	}
+
+	return s
+}
+
+func (t DiceTerm) String() string {
+	count := t.Count
+	if count < 0 {
+		count *= -1
+	}
+
+	s := fmt.Sprintf("%dd%d", count, t.Sides)
+	if t.Keep > 0 {
+		if t.KeepHighest {
+			s += fmt.Sprintf("kh%d", t.Keep)
+		} else {
+			s += fmt.Sprintf("kl%d", t.Keep)
+		}
+	}
+
+	return s
+}
+
+// Anything made up of digits, 'd', 'k', 'h', 'l' and operators is considered part of the dice notation
+func isNotation(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	if s[0] == '+' || s[0] == '-' {
+		return true
+	}
+
+	hasDigit := false
+	for _, ch := range s {
+		switch {
+		case ch >= '0' && ch <= '9':
+			hasDigit = true
+		case ch == 'd' || ch == 'k' || ch == 'h' || ch == 'l' || ch == '+' || ch == '-':
+		default:
+			return false
+		}
+	}
+
+	return hasDigit
+}
@@ -0,0 +1,280 @@
+package dice
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name         string
+		expression   string
+		terms        int
+		modifier     int
+		damageType   string
+		advantage    bool
+		disadvantage bool
+		expectErr    bool
+	}{
+		{
+			name:       "Dice with modifier",
+			expression: "2d6+3",
+			terms:      1,
+			modifier:   3,
+		},
+		{
+			name:       "Spaces around operators",
+			expression: "1d8 + 2",
+			terms:      1,
+			modifier:   2,
+		},
+		{
+			name:       "Damage type",
+			expression: "8d6 fire",
+			terms:      1,
+			damageType: "fire",
+		},
+		{
+			name:       "Multiple terms and negative modifier",
+			expression: "1d8+1d6-1",
+			terms:      2,
+			modifier:   -1,
+		},
+		{
+			name:       "Advantage",
+			expression: "1d20+5 adv",
+			terms:      1,
+			modifier:   5,
+			advantage:  true,
+		},
+		{
+			name:         "Disadvantage",
+			expression:   "d20 dis",
+			terms:        1,
+			disadvantage: true,
+		},
+		{
+			name:       "Advantage and disadvantage cancel out",
+			expression: "1d20 adv dis",
+			terms:      1,
+		},
+		{
+			name:       "Advantage without a d20",
+			expression: "2d6 adv",
+			expectErr:  true,
+		},
+		{
+			name:       "Empty expression",
+			expression: "",
+			expectErr:  true,
+		},
+		{
+			name:       "Keep more dice than rolled",
+			expression: "2d6kh3",
+			expectErr:  true,
+		},
+		{
+			name:       "Trailing operator",
+			expression: "1d6+",
+			expectErr:  true,
+		},
+		{
+			name:       "Not dice notation",
+			expression: "fire",
+			expectErr:  true,
+		},
+		{
+			name:       "Too many dice",
+			expression: "99999999999d6",
+			expectErr:  true,
+		},
+		{
+			name:       "Too many sides",
+			expression: "1d1001",
+			expectErr:  true,
+		},
+		{
+			name:       "Most dice allowed",
+			expression: "1000d1000",
+			terms:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Parse(tt.expression)
+
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Error- Expected an error for '%s'", tt.expression)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error- Unexpected error: %v", err)
+			}
+
+			if tt.terms != len(e.DiceTerms) {
+				t.Errorf("Terms- Expected: %d, Result: %d", tt.terms, len(e.DiceTerms))
+			}
+
+			if tt.modifier != e.Modifier {
+				t.Errorf("Modifier- Expected: %d, Result: %d", tt.modifier, e.Modifier)
+			}
+
+			if tt.damageType != e.DamageType {
+				t.Errorf("Damage Type- Expected: %s, Result: %s", tt.damageType, e.DamageType)
+			}
+
+			if tt.advantage != e.Advantage {
+				t.Errorf("Advantage- Expected: %t, Result: %t", tt.advantage, e.Advantage)
+			}
+
+			if tt.disadvantage != e.Disadvantage {
+				t.Errorf("Disadvantage- Expected: %t, Result: %t", tt.disadvantage, e.Disadvantage)
+			}
+		})
+	}
+}
+
+func TestRoll(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		dice       int
+		kept       int
+		minimum    int
+		maximum    int
+	}{
+		{
+			name:       "Dice with modifier",
+			expression: "2d6+3",
+			dice:       2,
+			kept:       2,
+			minimum:    5,
+			maximum:    15,
+		},
+		{
+			name:       "Keep highest",
+			expression: "4d6kh3",
+			dice:       4,
+			kept:       3,
+			minimum:    3,
+			maximum:    18,
+		},
+		{
+			name:       "Keep lowest",
+			expression: "4d6kl1",
+			dice:       4,
+			kept:       1,
+			minimum:    1,
+			maximum:    6,
+		},
+		{
+			name:       "Advantage rolls two d20s",
+			expression: "1d20+5 adv",
+			dice:       2,
+			kept:       1,
+			minimum:    6,
+			maximum:    25,
+		},
+		{
+			name:       "Subtracted dice",
+			expression: "10-1d4",
+			dice:       1,
+			kept:       1,
+			minimum:    6,
+			maximum:    9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRoller(1)
+
+			for range 100 {
+				result, err := r.Roll(tt.expression)
+				if err != nil {
+					t.Fatalf("Error- Unexpected error: %v", err)
+				}
+
+				dice := 0
+				kept := 0
+				for _, term := range result.Terms {
+					for _, d := range term.Dice {
+						dice++
+						if !d.Dropped {
+							kept++
+						}
+					}
+				}
+
+				if tt.dice != dice {
+					t.Errorf("Dice- Expected: %d, Result: %d", tt.dice, dice)
+				}
+
+				if tt.kept != kept {
+					t.Errorf("Kept- Expected: %d, Result: %d", tt.kept, kept)
+				}
+
+				if result.Total < tt.minimum || result.Total > tt.maximum {
+					t.Errorf("Total- Expected between %d and %d, Result: %d", tt.minimum, tt.maximum, result.Total)
+				}
+			}
+		})
+	}
+}
+
+func TestRollKeepsCorrectDice(t *testing.T) {
+	r := NewRoller(7)
+
+	for range 100 {
+		result, err := r.Roll("1d20 adv")
+		if err != nil {
+			t.Fatalf("Error- Unexpected error: %v", err)
+		}
+
+		d := result.Terms[0].Dice
+		expected := max(d[0].Value, d[1].Value)
+		if expected != result.Total {
+			t.Errorf("Advantage- Expected: %d, Result: %d", expected, result.Total)
+		}
+
+		if expected != result.Natural() {
+			t.Errorf("Natural- Expected: %d, Result: %d", expected, result.Natural())
+		}
+	}
+}
+
+func TestRollSeeded(t *testing.T) {
+	first, err := NewRoller(42).Roll("8d6 fire")
+	if err != nil {
+		t.Fatalf("Error- Unexpected error: %v", err)
+	}
+
+	second, err := NewRoller(42).Roll("8d6 fire")
+	if err != nil {
+		t.Fatalf("Error- Unexpected error: %v", err)
+	}
+
+	if first.String() != second.String() {
+		t.Errorf("Seeded Roll- Expected: %s, Result: %s", first.String(), second.String())
+	}
+}
+
+func TestRollCritical(t *testing.T) {
+	r := NewRoller(3)
+
+	result, err := r.RollCritical("2d6+3 slashing")
+	if err != nil {
+		t.Fatalf("Error- Unexpected error: %v", err)
+	}
+
+	if len(result.Terms[0].Dice) != 4 {
+		t.Errorf("Critical Dice- Expected: %d, Result: %d", 4, len(result.Terms[0].Dice))
+	}
+
+	if result.Modifier != 3 {
+		t.Errorf("Critical Modifier- Expected: %d, Result: %d", 3, result.Modifier)
+	}
+}
@@ -7,13 +7,15 @@ import (
 const (
 	itemNameInput = iota
 	itemQuantityInput
+	itemWeightInput
+	purseInput
 )
 
 func backpackInputs() []textinput.Model {
-	var inputs []textinput.Model = make([]textinput.Model, 2)
+	var inputs []textinput.Model = make([]textinput.Model, 4)
 
 	inputs[itemNameInput] = textinput.New()
-	inputs[itemNameInput].Placeholder = "Gold"
+	inputs[itemNameInput].Placeholder = "Rope (50 ft)"
 	inputs[itemNameInput].Focus()
 	inputs[itemNameInput].Width = 40
 	inputs[itemNameInput].Prompt = ""
@@ -27,5 +29,19 @@ func backpackInputs() []textinput.Model {
 	inputs[itemQuantityInput].TextStyle = tertiaryStyle
 	inputs[itemQuantityInput].Cursor.Style = tertiaryStyle
 
+	inputs[itemWeightInput] = textinput.New()
+	inputs[itemWeightInput].Placeholder = "10 (leave empty to look up)"
+	inputs[itemWeightInput].Width = 40
+	inputs[itemWeightInput].Prompt = ""
+	inputs[itemWeightInput].TextStyle = tertiaryStyle
+	inputs[itemWeightInput].Cursor.Style = tertiaryStyle
+
+	inputs[purseInput] = textinput.New()
+	inputs[purseInput].Placeholder = "15gp 5sp"
+	inputs[purseInput].Width = 40
+	inputs[purseInput].Prompt = ""
+	inputs[purseInput].TextStyle = tertiaryStyle
+	inputs[purseInput].Cursor.Style = tertiaryStyle
+
 	return inputs
 }
@@ -6,6 +6,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/onioncall/dndgo/character-management/handlers"
+	"github.com/onioncall/dndgo/character-management/models"
 	"github.com/onioncall/dndgo/character-management/shared"
 	"github.com/onioncall/dndgo/logger"
 	tui "github.com/onioncall/dndgo/tui/shared"
@@ -28,9 +29,10 @@ func (m Model) UpdateBackpackPage(msg tea.Msg) (Model, tea.Cmd) {
 					return m, nil
 				}
 
-				for i := range m.inputs {
-					m.inputs[i].SetValue("")
-				}
+				// The purse is kept so it can be saved along with the character
+				m.inputs[itemNameInput].SetValue("")
+				m.inputs[itemQuantityInput].SetValue("")
+				m.inputs[itemWeightInput].SetValue("")
 
 				m.focused = 0
 				m.inputs[itemNameInput].Focus()
@@ -39,6 +41,11 @@ func (m Model) UpdateBackpackPage(msg tea.Msg) (Model, tea.Cmd) {
 
 				return m, nil
 			} else if m.nextButtonFocused {
+				m.err = m.savePurse()
+				if m.err != nil {
+					return m, nil
+				}
+
 				err := handlers.CreateCharacter(m.character)
 				if err != nil {
 					logger.Error("Failed to create character:", '\n', err.Error())
@@ -120,12 +127,37 @@ func (m *Model) addBackpackItem() error {
 		return fmt.Errorf("Invalid item quantity, must be a positive integer")
 	}
 
+	itemWeight := handlers.GetEquipmentWeight(itemName)
+	if weightValue := m.inputs[itemWeightInput].Value(); weightValue != "" {
+		itemWeight, err = strconv.ParseFloat(weightValue, 64)
+		if err != nil || itemWeight < 0 {
+			return fmt.Errorf("Invalid item weight, must be a positive number")
+		}
+	}
+
 	item := shared.BackpackItem{
 		Name:     itemName,
 		Quantity: itemQuantity,
+		Weight:   itemWeight,
 	}
 
 	m.character.Backpack = append(m.character.Backpack, item)
 
 	return nil
 }
+
+func (m *Model) savePurse() error {
+	purseValue := m.inputs[purseInput].Value()
+	if purseValue == "" {
+		return nil
+	}
+
+	purse, err := models.ParseCurrency(purseValue)
+	if err != nil {
+		return err
+	}
+
+	m.character.Purse = purse
+
+	return nil
+}
@@ -22,10 +22,18 @@ func (m Model) BackpackPageView() string {
 		primaryStyle.Width(41).Render("Item Name"),
 		m.inputs[itemNameInput].View(),
 	)
-	formContent += fmt.Sprintf("%s\n%s\n",
+	formContent += fmt.Sprintf("%s\n%s\n\n",
 		primaryStyle.Width(41).Render("Item Quantity"),
 		m.inputs[itemQuantityInput].View(),
 	)
+	formContent += fmt.Sprintf("%s\n%s\n\n",
+		primaryStyle.Width(41).Render("Item Weight (lb)"),
+		m.inputs[itemWeightInput].View(),
+	)
+	formContent += fmt.Sprintf("%s\n%s\n",
+		primaryStyle.Width(41).Render("Purse"),
+		m.inputs[purseInput].View(),
+	)
 
 	addItemText := "add "
 	nextText := "save"
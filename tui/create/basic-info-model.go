@@ -44,7 +44,7 @@ func basicInfoInputs() []textinput.Model {
 	inputs[raceInput].Cursor.Style = tertiaryStyle
 
 	inputs[backgroundInput] = textinput.New()
-	inputs[backgroundInput].Placeholder = "Optional"
+	inputs[backgroundInput].Placeholder = "Optional, ex: acolyte"
 	inputs[backgroundInput].Width = 40
 	inputs[backgroundInput].Prompt = ""
 	inputs[backgroundInput].TextStyle = tertiaryStyle
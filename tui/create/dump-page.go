@@ -6,6 +6,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/onioncall/dndgo/character-management/models"
 	tui "github.com/onioncall/dndgo/tui/shared"
 )
 
@@ -25,6 +26,11 @@ func (m Model) DumpView() string {
 	allLines = append(allLines, fmt.Sprintf("Class: %v", m.character.ClassTypes))
 	allLines = append(allLines, fmt.Sprintf("Race: %v", m.character.Race))
 	allLines = append(allLines, fmt.Sprintf("Background: %v", m.character.Background))
+	allLines = append(allLines, fmt.Sprintf("Tool Proficiencies: %v", strings.Join(m.character.ToolProficiencies, ", ")))
+	if len(m.character.BackgroundDuplicates) > 0 {
+		allLines = append(allLines, fmt.Sprintf("Already Proficient (use replace-proficiency): %v",
+			strings.Join(m.character.BackgroundDuplicates, ", ")))
+	}
 	allLines = append(allLines, fmt.Sprintf("Languages: %v", strings.Join(m.character.Languages, ", ")))
 	allLines = append(allLines, fmt.Sprintf("HP Current: %v", m.character.HPCurrent))
 	allLines = append(allLines, fmt.Sprintf("HP Max: %v", m.character.HPMax))
@@ -76,10 +82,13 @@ func (m Model) DumpView() string {
 
 	allLines = append(allLines, "Backpack Items")
 	for _, b := range m.character.Backpack {
-		allLines = append(allLines, fmt.Sprintf("%s: %d", b.Name, b.Quantity))
+		allLines = append(allLines, fmt.Sprintf("%s: %d (%s)", b.Name, b.Quantity, models.FormatWeight(b.Weight)))
 	}
 	allLines = append(allLines, "")
 
+	allLines = append(allLines, fmt.Sprintf("Purse: %s", models.FormatCurrency(m.character.Purse)))
+	allLines = append(allLines, "")
+
 	dumpLength = len(allLines)
 	// Calculate visible lines
 	availableLines := m.height - 2 // Leave room for instructions
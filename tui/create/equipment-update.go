@@ -88,14 +88,14 @@ func (m Model) UpdateEquipmentPage(msg tea.Msg) (Model, tea.Cmd) {
 }
 
 func (m *Model) saveEquipment() error {
-	m.character.WornEquipment.Head = m.inputs[headInput].Value()
-	m.character.WornEquipment.Amulet = m.inputs[amuletInput].Value()
-	m.character.WornEquipment.Cloak = m.inputs[cloakInput].Value()
-	m.character.WornEquipment.HandsArms = m.inputs[handsArmsInput].Value()
-	m.character.WornEquipment.Ring = m.inputs[ringInput].Value()
-	m.character.WornEquipment.Ring2 = m.inputs[ring2Input].Value()
-	m.character.WornEquipment.Belt = m.inputs[beltInput].Value()
-	m.character.WornEquipment.Boots = m.inputs[bootsInput].Value()
+	m.character.WornEquipment.Head = shared.MagicItem{Name: m.inputs[headInput].Value()}
+	m.character.WornEquipment.Amulet = shared.MagicItem{Name: m.inputs[amuletInput].Value()}
+	m.character.WornEquipment.Cloak = shared.MagicItem{Name: m.inputs[cloakInput].Value()}
+	m.character.WornEquipment.HandsArms = shared.MagicItem{Name: m.inputs[handsArmsInput].Value()}
+	m.character.WornEquipment.Ring = shared.MagicItem{Name: m.inputs[ringInput].Value()}
+	m.character.WornEquipment.Ring2 = shared.MagicItem{Name: m.inputs[ring2Input].Value()}
+	m.character.WornEquipment.Belt = shared.MagicItem{Name: m.inputs[beltInput].Value()}
+	m.character.WornEquipment.Boots = shared.MagicItem{Name: m.inputs[bootsInput].Value()}
 	m.character.WornEquipment.Shield = m.inputs[shieldInput].Value()
 
 	armorValue := m.inputs[armorInput].Value()
@@ -138,14 +138,14 @@ func (m *Model) saveEquipment() error {
 }
 
 func (m *Model) populateEquipmentInputs() {
-	m.inputs[headInput].SetValue(m.character.WornEquipment.Head)
-	m.inputs[amuletInput].SetValue(m.character.WornEquipment.Amulet)
-	m.inputs[cloakInput].SetValue(m.character.WornEquipment.Cloak)
-	m.inputs[handsArmsInput].SetValue(m.character.WornEquipment.HandsArms)
-	m.inputs[ringInput].SetValue(m.character.WornEquipment.Ring)
-	m.inputs[ring2Input].SetValue(m.character.WornEquipment.Ring2)
-	m.inputs[beltInput].SetValue(m.character.WornEquipment.Belt)
-	m.inputs[bootsInput].SetValue(m.character.WornEquipment.Boots)
+	m.inputs[headInput].SetValue(m.character.WornEquipment.Head.Name)
+	m.inputs[amuletInput].SetValue(m.character.WornEquipment.Amulet.Name)
+	m.inputs[cloakInput].SetValue(m.character.WornEquipment.Cloak.Name)
+	m.inputs[handsArmsInput].SetValue(m.character.WornEquipment.HandsArms.Name)
+	m.inputs[ringInput].SetValue(m.character.WornEquipment.Ring.Name)
+	m.inputs[ring2Input].SetValue(m.character.WornEquipment.Ring2.Name)
+	m.inputs[beltInput].SetValue(m.character.WornEquipment.Belt.Name)
+	m.inputs[bootsInput].SetValue(m.character.WornEquipment.Boots.Name)
 	m.inputs[shieldInput].SetValue(m.character.WornEquipment.Shield)
 
 	armor := m.character.WornEquipment.Armor
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/onioncall/dndgo/character-management/models"
 	"github.com/onioncall/dndgo/character-management/shared"
 	tui "github.com/onioncall/dndgo/tui/shared"
 )
@@ -134,123 +135,25 @@ func (m *Model) addSpell() error {
 }
 
 func (m *Model) configureSpellSlots() {
-	hasSpellClass := false
-	for _, class := range m.character.ClassTypes {
-		class = strings.ToLower(class)
-
-		// These classes don't support spells, no need to do any of this if no classes are in the following
-		if class == shared.ClassBarbarian ||
-			class == shared.ClassFighter ||
-			class == shared.ClassMonk ||
-			class == shared.ClassRogue {
-			continue
-		}
-
-		hasSpellClass = true
-		break
+	classLevels := make(map[string]int)
+	for className, classLevel := range m.classMap {
+		classLevels[strings.ToLower(className)] += classLevel
 	}
 
-	if !hasSpellClass {
+	// Classes without spellcasting have no slots, no need to list empty ones
+	maximums := models.GetSpellSlotMaximums(classLevels)
+	if maximums[0] == 0 {
+		m.character.SpellSlots = nil
 		return
 	}
 
-	fullCasterProgression := map[int]map[int]int{
-		1:  {1: 2},
-		2:  {1: 3},
-		3:  {1: 4, 2: 2},
-		4:  {1: 4, 2: 3},
-		5:  {1: 4, 2: 3, 3: 2},
-		6:  {1: 4, 2: 3, 3: 3},
-		7:  {1: 4, 2: 3, 3: 3, 4: 1},
-		8:  {1: 4, 2: 3, 3: 3, 4: 2},
-		9:  {1: 4, 2: 3, 3: 3, 4: 3, 5: 1},
-		10: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2},
-		11: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2, 6: 1},
-		12: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2, 6: 1},
-		13: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2, 6: 1, 7: 1},
-		14: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2, 6: 1, 7: 1},
-		15: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2, 6: 1, 7: 1, 8: 1},
-		16: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2, 6: 1, 7: 1, 8: 1},
-		17: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2, 6: 1, 7: 1, 8: 1, 9: 1},
-		18: {1: 4, 2: 3, 3: 3, 4: 3, 5: 3, 6: 1, 7: 1, 8: 1, 9: 1},
-		19: {1: 4, 2: 3, 3: 3, 4: 3, 5: 3, 6: 2, 7: 1, 8: 1, 9: 1},
-		20: {1: 4, 2: 3, 3: 3, 4: 3, 5: 3, 6: 2, 7: 2, 8: 1, 9: 1},
-	}
-
-	halfCasterProgression := map[int]map[int]int{
-		2:  {1: 2},
-		3:  {1: 3},
-		4:  {1: 3},
-		5:  {1: 4, 2: 2},
-		6:  {1: 4, 2: 2},
-		7:  {1: 4, 2: 3},
-		8:  {1: 4, 2: 3},
-		9:  {1: 4, 2: 3, 3: 2},
-		10: {1: 4, 2: 3, 3: 2},
-		11: {1: 4, 2: 3, 3: 3},
-		12: {1: 4, 2: 3, 3: 3},
-		13: {1: 4, 2: 3, 3: 3, 4: 1},
-		14: {1: 4, 2: 3, 3: 3, 4: 1},
-		15: {1: 4, 2: 3, 3: 3, 4: 2},
-		16: {1: 4, 2: 3, 3: 3, 4: 2},
-		17: {1: 4, 2: 3, 3: 3, 4: 3, 5: 1},
-		18: {1: 4, 2: 3, 3: 3, 4: 3, 5: 1},
-		19: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2},
-		20: {1: 4, 2: 3, 3: 3, 4: 3, 5: 2},
-	}
-
-	warlockProgression := map[int]map[int]int{
-		1:  {1: 1},
-		2:  {1: 2},
-		3:  {2: 2},
-		4:  {2: 2},
-		5:  {3: 2},
-		6:  {3: 2},
-		7:  {4: 2},
-		8:  {4: 2},
-		9:  {5: 2},
-		10: {5: 2},
-		11: {5: 3},
-		12: {5: 3},
-		13: {5: 3},
-		14: {5: 3},
-		15: {5: 3},
-		16: {5: 3},
-		17: {5: 4},
-		18: {5: 4},
-		19: {5: 4},
-		20: {5: 4},
-	}
-
-	spellSlotTable := map[string]map[int]map[int]int{
-		shared.ClassBard:     fullCasterProgression,
-		shared.ClassCleric:   fullCasterProgression,
-		shared.ClassDruid:    fullCasterProgression,
-		shared.ClassSorcerer: fullCasterProgression,
-		shared.ClassWizard:   fullCasterProgression,
-		shared.ClassPaladin:  halfCasterProgression,
-		shared.ClassRanger:   halfCasterProgression,
-		shared.ClassWarlock:  warlockProgression,
-	}
-
-	slotMap := make(map[int]int)
-	for className, classLevel := range m.classMap {
-		if classTable, ok := spellSlotTable[strings.ToLower(className)]; ok {
-			if levelSlots, ok := classTable[classLevel]; ok {
-				// Sum the slots at each spell level
-				for slotLevel, numSlots := range levelSlots {
-					slotMap[slotLevel] += numSlots
-				}
-			}
-		}
-	}
-
 	m.character.SpellSlots = []shared.SpellSlot{}
-	for i := 1; i <= 9; i++ {
+	for i, maximum := range maximums {
 		slot := shared.SpellSlot{
-			Level:     i,
-			Available: slotMap[i],
-			Maximum:   slotMap[i],
+			Level:     i + 1,
+			Available: maximum,
+			Maximum:   maximum,
+			Expected:  maximum,
 		}
 
 		m.character.SpellSlots = append(m.character.SpellSlots, slot)
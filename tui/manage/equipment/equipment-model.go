@@ -38,6 +38,12 @@ func GetBackpackContent(character models.Character, width int) string {
 	var contentWithoutSpacers []string
 	for _, item := range character.Backpack {
 		itemStr := fmt.Sprintf("%d - %s", item.Quantity, item.Name)
+		if item.Weight > 0 {
+			itemStr += fmt.Sprintf(" (%s)", models.FormatWeight(item.Weight))
+		}
+		if item.Attuned {
+			itemStr += " (attuned)"
+		}
 		contentWithoutSpacers = append(contentWithoutSpacers, itemStr)
 		maxLength = max(maxLength, utf8.RuneCountInString(itemStr))
 	}
@@ -48,6 +54,10 @@ func GetBackpackContent(character models.Character, width int) string {
 		backpackContent += fmt.Sprintf("%s%s\n", item, strings.Repeat("\u00A0", maxLength-itemLength))
 	}
 
+	purseStr := shared.TruncateString(fmt.Sprintf("Purse: %s", models.FormatCurrency(character.Purse)), width)
+	loadStr := shared.TruncateString(fmt.Sprintf("Load: %s", character.GetLoad()), width)
+	backpackContent += fmt.Sprintf("\n%s\n%s\n", purseStr, loadStr)
+
 	return backpackContent
 }
 
@@ -57,19 +67,19 @@ func GetWornEquipmentContent(character models.Character, width int) string {
 	equipmentContent += fmt.Sprintf("%s\n", strings.Repeat("─", width))
 	headerLen := 14
 
-	amuletStr := shared.TruncateString(fmt.Sprintf("Amulet: %s", character.WornEquipment.Amulet), width)
+	amuletStr := shared.TruncateString(fmt.Sprintf("Amulet: %s", models.FormatMagicItem(character.WornEquipment.Amulet)), width)
 	amuletLen := utf8.RuneCountInString(amuletStr)
-	beltStr := shared.TruncateString(fmt.Sprintf("Belt: %s", character.WornEquipment.Belt), width)
+	beltStr := shared.TruncateString(fmt.Sprintf("Belt: %s", models.FormatMagicItem(character.WornEquipment.Belt)), width)
 	beltLen := utf8.RuneCountInString(beltStr)
-	bootsStr := shared.TruncateString(fmt.Sprintf("Boots: %s", character.WornEquipment.Boots), width)
+	bootsStr := shared.TruncateString(fmt.Sprintf("Boots: %s", models.FormatMagicItem(character.WornEquipment.Boots)), width)
 	bootsLen := utf8.RuneCountInString(bootsStr)
-	cloakStr := shared.TruncateString(fmt.Sprintf("Cloak: %s", character.WornEquipment.Cloak), width)
+	cloakStr := shared.TruncateString(fmt.Sprintf("Cloak: %s", models.FormatMagicItem(character.WornEquipment.Cloak)), width)
 	cloakLen := utf8.RuneCountInString(cloakStr)
-	headStr := shared.TruncateString(fmt.Sprintf("Helmet: %s", character.WornEquipment.Head), width)
+	headStr := shared.TruncateString(fmt.Sprintf("Helmet: %s", models.FormatMagicItem(character.WornEquipment.Head)), width)
 	headLen := utf8.RuneCountInString(headStr)
-	ringStr := shared.TruncateString(fmt.Sprintf("Ring: %s", character.WornEquipment.Ring), width)
+	ringStr := shared.TruncateString(fmt.Sprintf("Ring: %s", models.FormatMagicItem(character.WornEquipment.Ring)), width)
 	ringLen := utf8.RuneCountInString(ringStr)
-	ring2Str := shared.TruncateString(fmt.Sprintf("Ring2: %s", character.WornEquipment.Ring2), width)
+	ring2Str := shared.TruncateString(fmt.Sprintf("Ring2: %s", models.FormatMagicItem(character.WornEquipment.Ring2)), width)
 	ring2Len := utf8.RuneCountInString(ring2Str)
 	armorStr := shared.TruncateString(fmt.Sprintf("Armor: %s", character.WornEquipment.Armor.Name), width)
 	armorLen := utf8.RuneCountInString(armorStr)
@@ -85,6 +95,9 @@ func GetWornEquipmentContent(character models.Character, width int) string {
 	equipmentContent += fmt.Sprintf("%s%s\n", ring2Str, strings.Repeat("\u00A0", maxLen-ring2Len))
 	equipmentContent += fmt.Sprintf("%s%s\n", ringStr, strings.Repeat("\u00A0", maxLen-ringLen))
 
+	attunedStr := shared.TruncateString(fmt.Sprintf("Attuned: %s", character.GetAttunement()), width)
+	equipmentContent += fmt.Sprintf("\n%s%s\n", attunedStr, strings.Repeat("\u00A0", max(maxLen-utf8.RuneCountInString(attunedStr), 0)))
+
 	return equipmentContent
 }
 
@@ -120,23 +133,9 @@ func GetWeaponsContent(character models.Character, width int) string {
 
 	for _, w := range character.Weapons {
 		normalLongStr := fmt.Sprintf("%d/%d", w.Range.NormalRange, w.Range.LongRange)
-		bonusStr := ""
-		if w.Proficient {
-			bonusStr = fmt.Sprintf("%d", w.Bonus+character.Proficiency)
-		} else {
-			bonusStr = fmt.Sprintf("%d", w.Bonus)
-		}
-		if w.Bonus >= 0 {
-			bonusStr = fmt.Sprintf("%s%s", "+", bonusStr)
-		}
+		bonusStr := fmt.Sprintf("%+d", w.AttackBonus)
 		propertiesStr := strings.Join(w.Properties, ", ")
-
-		damageStr := w.Damage
-		if w.Bonus >= 0 {
-			damageStr += fmt.Sprintf(" +%d", w.Bonus)
-		} else {
-			damageStr += fmt.Sprintf(" %d", w.Bonus)
-		}
+		damageStr := models.GetWeaponDamage(w)
 
 		nameStr := w.Name
 		if strings.ToLower(character.PrimaryEquipped) == strings.ToLower(w.Name) {
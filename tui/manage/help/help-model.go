@@ -21,16 +21,42 @@ Available Commands:
   • damage <amount>        	- Deal damage to your character
   • recover <amount>       	- Heal your character (use "all" for long rest recovery)
   • temp <amount>          	- Add temporary hit points
+  • add-xp <amount>        	- Add experience points (negative to remove)
+  • race <race>/<subrace>  	- Set your race and optional subrace (ex: dwarf/hill)
+  • racial-bonus <mode>/<abilities>	- Use fixed or flexible racial bonuses (ex: flexible/dexterity,wisdom)
+  • replace-proficiency <duplicate>/<new>	- Replace a proficiency your background granted twice
+  • short-rest <hit dice>  	- Short rest, spending hit dice to heal (ex: 2d10)
+  • death-save             	- Roll a death saving throw while at 0 HP
+  • condition <name>       	- Apply a condition (poisoned, prone, restrained, frightened, exhaustion)
+  • remove-condition <name>	- Remove a condition, or "all" to clear them
   • rename <name>          	- Change your character's name
-  • use-slot <level>       	- Use a spell slot
-  • recover-slot <level>   	- Recover a spell slot
+  • use-slot <level>       	- Use a spell slot, or "pact" for a pact magic slot
+  • recover-slot <level>   	- Recover a spell slot, or "pact" for a pact magic slot
+  • cast <spell>/<level>   	- Cast a known spell, tracking concentration (level or "pact" optional, then /metamagic)
+  • create-slot <level>    	- Create a spell slot from sorcery points
+  • convert-slot <level>   	- Turn a spell slot into sorcery points
+  • arcane-recovery <levels>	- Recover expended spell slots after a short rest (ex: 1,1)
+  • concentration-save     	- Roll a constitution save to keep concentrating
+  • drop-concentration     	- Stop concentrating on your current spell
   • equip <weapon>         	- Equip a weapon
   • unequip <slot>         	- Unequip a weapon (primary/secondary)
+  • attune <item>          	- Attune to a worn or backpack magic item (up to 3)
+  • unattune <item>        	- End attunement to a magic item
+  • attack <weapon>/<adv>  	- Roll to hit and damage (primary/secondary/weapon name, optional adv/dis)
+  • roll <expression>      	- Roll dice, ex: 2d6+3, 1d20+5 adv, 4d6kh3, 8d6 fire
   
-  • add-item <name>/<(optional) qty>                 - Add item to backpack (default 1)
+  • add-item <name>/<(optional) qty>/<(optional) lb> - Add item to backpack (default 1, weight looked up)
   • remove-item <name>/<(optional) qty>              - Remove item from backpack (default 1)
+  • add-money <amount>                               - Add money to purse, ex: 3gp 5sp
+  • spend-money <amount>                             - Spend money from purse, making change as needed
+  • convert-money <coins>/<denomination>             - Convert coins, ex: 20sp/gp
   • use-token <(optional) name>/<(optional) qty>     - Use class token (default 1)
   • recover-token <(optional) name>/<(optional) qty> - Remove item from backpack (default full)
+  • level-up <(optional) class>/<(optional) roll>    - Level up a class (default current class, average hp)
+  • asi <ability> or asi <ability>,<ability>         - Ability score improvement, +2 to one or +1 to two
+  • feat <name>/<(optional) ability>                 - Take a feat instead of an ability score improvement
+  • sub-class <name>                                 - Choose a subclass for the current class
+  • swap-spell <known spell>/<new spell>             - Replace a known spell after leveling up
 
   * Optional Values
     ◦ Default behavior for adding, using, or removing an unspecified quantity is to use value of 1
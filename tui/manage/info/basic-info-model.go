@@ -7,6 +7,7 @@ import (
 
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/onioncall/dndgo/character-management/models"
+	"github.com/onioncall/dndgo/character-management/shared"
 )
 
 type BasicInfoModel struct {
@@ -33,7 +34,11 @@ func NewBasicInfoModel() BasicInfoModel {
 
 func GetHealthContent(character models.Character) string {
 	healthContent := fmt.Sprintf("Current HP: %d | Max HP: %d | Temp HP: %d",
-		character.HPCurrent, character.HPMax, character.HPTemp)
+		character.HPCurrent, character.HPMaxAdjusted, character.HPTemp)
+
+	if status := character.DeathSaveStatus(); status != "" {
+		healthContent += fmt.Sprintf("\nDeath Saves: %s", status)
+	}
 
 	return healthContent
 }
@@ -41,23 +46,65 @@ func GetHealthContent(character models.Character) string {
 func GetStatsContent(character models.Character) string {
 	asi := ""
 	for _, item := range character.AbilityScoreImprovement {
+		if item.Feat != "" {
+			asi += fmt.Sprintf("- feat: %s\n", item.Feat)
+			continue
+		}
+
 		asi += fmt.Sprintf("- %s: +%d\n", item.Ability, item.Bonus)
 	}
 
+	conditions := "none"
+	if c := character.GetConditions(); len(c) > 0 {
+		conditions = strings.Join(c, ", ")
+	}
+
+	senses := ""
+	if character.Darkvision > 0 {
+		senses += fmt.Sprintf("Darkvision: %d ft\n", character.Darkvision)
+	}
+	if resistances := character.GetResistances(); len(resistances) > 0 {
+		senses += fmt.Sprintf("Resistances: %s\n", strings.Join(resistances, ", "))
+	}
+
+	traits := []string{}
+	for _, trait := range character.RaceTraits {
+		traits = append(traits, trait.Name)
+	}
+	if len(traits) > 0 {
+		senses += fmt.Sprintf("Racial Traits: %s\n", strings.Join(traits, ", "))
+	}
+
+	background := ""
+	if character.Background != "" {
+		background += fmt.Sprintf("Background: %s\n", character.Background)
+	}
+	if len(character.ToolProficiencies) > 0 {
+		background += fmt.Sprintf("Tools: %s\n", strings.Join(character.ToolProficiencies, ", "))
+	}
+	if len(character.BackgroundDuplicates) > 0 {
+		background += fmt.Sprintf("Replace Proficiencies: %s\n", strings.Join(character.BackgroundDuplicates, ", "))
+	}
+
 	statsContent := fmt.Sprintf(`Class: %s
 Level: %d
+XP: %s
 Race: %s
-Proficiency: +%d
+%sProficiency: +%d
 Speed:  %d
+%sLoad: %s
 Passive Perception: %d
 Passive Insight: %d
+Passive Investigation: %d
 AC: %d
+Initiative: %+d
 Hit Dice: %s
+Conditions: %s
 Ability Score Improvement:
 %s`,
-		strings.Join(character.ClassTypes, ", "), character.Level, character.Race, character.Proficiency,
-		character.Speed, character.PassivePerception, character.PassiveInsight,
-		character.AC, character.HitDice, asi)
+		strings.Join(character.ClassTypes, ", "), character.Level, character.GetXPProgress(), character.GetRaceName(), background, character.Proficiency,
+		character.SpeedAdjusted, senses, character.GetLoad(), character.PassivePerception, character.PassiveInsight,
+		character.PassiveInvestigation, character.AC, character.Initiative, character.HitDice, conditions, asi)
 
 	return statsContent
 }
@@ -74,10 +121,7 @@ func GetAbilitiesContent(character models.Character, width int) string {
 		if a.AbilityModifier >= 0 {
 			modStr = fmt.Sprintf("+%d", a.AbilityModifier)
 		}
-		st := a.AbilityModifier
-		if a.SavingThrowsProficient {
-			st += character.Proficiency
-		}
+		st := character.GetSavingThrowMod(a.Name)
 		stStr := fmt.Sprintf("%d", st)
 		if st >= 0 {
 			stStr = fmt.Sprintf("+%d", st)
@@ -93,6 +137,15 @@ func GetAbilitiesContent(character models.Character, width int) string {
 			strings.Repeat("\u00A0", lineWidth-utf8.RuneCountInString(abilityStr)))
 	}
 
+	saveNote := character.RollNote(shared.RollTypeSavingThrow, "")
+	if saveNote != "" {
+		abilitiesStr += fmt.Sprintf("\nSaving Throws: %s\n", saveNote)
+	}
+
+	if dexNote := character.RollNote(shared.RollTypeSavingThrow, shared.AbilityDexterity); dexNote != saveNote {
+		abilitiesStr += fmt.Sprintf("\nDexterity Saves: %s\n", dexNote)
+	}
+
 	return abilitiesStr
 }
 
@@ -124,6 +177,14 @@ func GetSkillsContent(character models.Character, width int) string {
 			strings.Repeat("\u00A0", lineWidth-utf8.RuneCountInString(skillStr)))
 	}
 
+	if note := character.RollNote(shared.RollTypeAbilityCheck, ""); note != "" {
+		skillsStr += fmt.Sprintf("\nAbility Checks: %s\n", note)
+	}
+
+	if note := character.RollNote(shared.RollTypeAttack, ""); note != "" {
+		skillsStr += fmt.Sprintf("Attack Rolls: %s\n", note)
+	}
+
 	return skillsStr
 }
 
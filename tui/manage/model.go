@@ -2,6 +2,7 @@ package manage
 
 import (
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/onioncall/dndgo/character-management/handlers"
 	"github.com/onioncall/dndgo/character-management/models"
 	"github.com/onioncall/dndgo/logger"
@@ -22,6 +23,7 @@ type Model struct {
 	contentInitialized bool
 	currentClass       string
 	err                error
+	result             string
 
 	basicInfoTab info.BasicInfoModel
 	spellsTab    spells.SpellsModel
@@ -65,6 +67,8 @@ const (
 	addItemKeybinding
 	useClassTokenKeybinding
 	recoverClassTokenKeybinding
+	attackKeybinding
+	shortRestKeybinding
 )
 
 const cmdInactive = 99
@@ -78,27 +82,58 @@ const (
 	classCmd     = "c"
 	helpCmd      = "h"
 
+	// General
+	rollCmd = "roll"
+
 	// Basic Info
-	damageCmd  = "damage"
-	recoverCmd = "recover"
-	addTempCmd = "temp"
-	renameCmd  = "rename"
+	damageCmd          = "damage"
+	recoverCmd         = "recover"
+	addTempCmd         = "temp"
+	shortRestCmd       = "short-rest"
+	deathSaveCmd       = "death-save"
+	conditionCmd       = "condition"
+	removeConditionCmd = "remove-condition"
+	renameCmd          = "rename"
+	addXPCmd           = "add-xp"
+	raceCmd            = "race"
+	racialBonusCmd     = "racial-bonus"
+	replaceProfCmd     = "replace-proficiency"
 
 	// Spell Slots
-	useSlotCmd     = "use-slot"
-	recoverSlotCmd = "recover-slot"
+	useSlotCmd           = "use-slot"
+	recoverSlotCmd       = "recover-slot"
+	castCmd              = "cast"
+	createSlotCmd        = "create-slot"
+	convertSlotCmd       = "convert-slot"
+	arcaneRecoveryCmd    = "arcane-recovery"
+	concentrationSaveCmd = "concentration-save"
+	dropConcentrationCmd = "drop-concentration"
+
+	// Used in place of a slot level to use a Pact Magic slot
+	pactSlotArg = "pact"
 
 	// Equipment
 	addEquipmentCmd = "add-equipment"
 	equipCmd        = "equip"
 	unequipCmd      = "unequip"
+	attuneCmd       = "attune"
+	unattuneCmd     = "unattune"
 	addItemCmd      = "add-item"
 	removeItemCmd   = "remove-item"
+	addMoneyCmd     = "add-money"
+	spendMoneyCmd   = "spend-money"
+	convertMoneyCmd = "convert-money"
 	updateClassCmd  = "update-class"
+	attackCmd       = "attack"
 
 	// Class
 	useClassTokenCmd     = "use-token"
 	recoverClassTokenCmd = "recover-token"
+	levelUpCmd           = "level-up"
+	asiCmd               = "asi"
+	featCmd              = "feat"
+	subClassCmd          = "sub-class"
+	swapSpellCmd         = "swap-spell"
 )
 
 func NewModel() Model {
@@ -143,6 +178,12 @@ func NewModel() Model {
 	longRestInput.Prompt = " long rest?> "
 	longRestInput.Width = 38
 
+	shortRestInput := textinput.New()
+	shortRestInput.Focus()
+	shortRestInput.Placeholder = "hit dice to spend, ex: 2d10..."
+	shortRestInput.Prompt = " short rest> "
+	shortRestInput.Width = 38
+
 	useSpellInput := textinput.New()
 	useSpellInput.Focus()
 	useSpellInput.Placeholder = "level of slot to use..."
@@ -155,6 +196,12 @@ func NewModel() Model {
 	useTokenInput.Prompt = " use token> "
 	useTokenInput.Width = 38
 
+	attackInput := textinput.New()
+	attackInput.Focus()
+	attackInput.Placeholder = "primary, secondary, or weapon/adv..."
+	attackInput.Prompt = " attack> "
+	attackInput.Width = 38
+
 	navInput := textinput.New()
 	navInput.Focus()
 	navInput.Placeholder = "b, s, e, c, h"
@@ -167,8 +214,10 @@ func NewModel() Model {
 	keyBindings[damageKeybinding] = keyBinding{"ctrl+d", ExecDamageKeyBinding, &damageInput}
 	keyBindings[recoverHpKeybinding] = keyBinding{"ctrl+r", ExecRecoverKeyBinding, &recoverHpInput}
 	keyBindings[longRestKeybinding] = keyBinding{"ctrl+l", ExecLongRestKeyBinding, &longRestInput}
+	keyBindings[shortRestKeybinding] = keyBinding{"ctrl+k", ExecShortRestKeyBinding, &shortRestInput}
 	keyBindings[useSpellKeybinding] = keyBinding{"ctrl+s", ExecUseSpellKeyBinding, &useSpellInput}
 	keyBindings[useClassTokenKeybinding] = keyBinding{"ctrl+t", ExecUseClassTokenKeyBinding, &useTokenInput}
+	keyBindings[attackKeybinding] = keyBinding{"ctrl+a", ExecAttackKeyBinding, &attackInput}
 	keyBindings[navKeyBinding] = keyBinding{"/", ExecNavKeyBinding, &navInput}
 
 	// Currently can't get shift+char to work, so holding off on implementing the following until I do
@@ -180,18 +229,44 @@ func NewModel() Model {
 	commands := []string{
 		addItemCmd,
 		addTempCmd,
+		addXPCmd,
+		racialBonusCmd,
+		raceCmd,
+		replaceProfCmd,
+		levelUpCmd,
+		asiCmd,
+		featCmd,
+		subClassCmd,
+		swapSpellCmd,
 		damageCmd,
 		removeItemCmd,
+		addMoneyCmd,
+		spendMoneyCmd,
+		convertMoneyCmd,
 		equipCmd,
 		recoverSlotCmd,
 		recoverClassTokenCmd,
 		recoverCmd,
 		addEquipmentCmd,
+		attackCmd,
 		updateClassCmd,
 		unequipCmd,
+		attuneCmd,
+		unattuneCmd,
 		useSlotCmd,
 		useClassTokenCmd,
 		renameCmd,
+		shortRestCmd,
+		deathSaveCmd,
+		castCmd,
+		createSlotCmd,
+		convertSlotCmd,
+		arcaneRecoveryCmd,
+		concentrationSaveCmd,
+		dropConcentrationCmd,
+		conditionCmd,
+		removeConditionCmd,
+		rollCmd,
 		basicInfoCmd,
 		spellCmd,
 		equipmentCmd,
@@ -230,10 +305,7 @@ func NewModel() Model {
 
 func (m Model) getInnerDimensions() (width, height int) {
 	outerBorderMargin := 2
-	bottomBoxHeight := 0
-	if m.visibleCmd != 99 || m.err != nil {
-		bottomBoxHeight = 3
-	}
+	bottomBoxHeight := m.getBottomBoxHeight()
 
 	containerWidth := m.width - (outerBorderMargin * 2) - 2
 	containerHeight := m.height - (outerBorderMargin * 2) - 2 - bottomBoxHeight
@@ -247,3 +319,16 @@ func (m Model) getInnerDimensions() (width, height int) {
 
 	return innerWidth, availableHeight
 }
+
+// Cmd and error boxes are a single line, results (like rolls) can span multiple lines
+func (m Model) getBottomBoxHeight() int {
+	if m.visibleCmd != cmdInactive || m.err != nil {
+		return 3
+	}
+
+	if m.result != "" {
+		return lipgloss.Height(m.renderResultBox())
+	}
+
+	return 0
+}
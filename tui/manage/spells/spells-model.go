@@ -35,6 +35,12 @@ func GetKnownSpellContent(character models.Character, width int) string {
 	longestSpellNameWidth := 4
 	maxSpellNameWidth := width - 29 // based on width of viewport and characters in header
 
+	showStats := maxSpellNameWidth-statsColumnWidth >= minSpellNameWidth
+	if showStats {
+		maxSpellNameWidth -= statsColumnWidth
+	}
+	maxSpellNameWidth = max(maxSpellNameWidth, longestSpellNameWidth)
+
 	spellNames := make(map[string]string)
 	for _, s := range character.Spells {
 		spellNameLen := utf8.RuneCountInString(s.Name)
@@ -47,8 +53,16 @@ func GetKnownSpellContent(character models.Character, width int) string {
 
 	knownSpellsHeader := fmt.Sprintf("Level  - Name%s - Ritual - Prepared",
 		strings.Repeat(" ", longestSpellNameWidth-4))
+	if showStats {
+		knownSpellsHeader += " - DC/Attack"
+	}
+
+	knownSpellsContent := ""
+	if preparedSummary := character.GetPreparedSpellsSummary(); preparedSummary != "" {
+		knownSpellsContent += fmt.Sprintf("%s\n", preparedSummary)
+	}
 
-	knownSpellsContent := fmt.Sprintf("%s\n", knownSpellsHeader)
+	knownSpellsContent += fmt.Sprintf("%s\n", knownSpellsHeader)
 	knownSpellsContent += fmt.Sprintf("%s\n", strings.Repeat("─", width))
 
 	for _, s := range character.Spells {
@@ -64,12 +78,28 @@ func GetKnownSpellContent(character models.Character, width int) string {
 		nameLen := utf8.RuneCountInString(spellNames[s.Name])
 		knownSpellStr := fmt.Sprintf("lvl: %d - %s%s - %s - %s",
 			s.SlotLevel, spellNames[s.Name], strings.Repeat(" ", longestSpellNameWidth-nameLen), ritualStr, preparedStr)
+
+		// Each spell uses the save DC and attack modifier of the class it was learned through
+		if showStats {
+			stats := character.GetSpellStats(s)
+			knownSpellStr += fmt.Sprintf(" - %d/%+d", stats.SpellSaveDC, stats.SpellAttackMod)
+		}
 		knownSpellsContent += fmt.Sprintf("%s\n\n", knownSpellStr)
 	}
 
 	return knownSpellsContent
 }
 
+func GetSpellSaveDCContent(character models.Character) string {
+	dcStr := fmt.Sprintf("Spell Save DC: %s", character.GetSpellSaveDCSummary())
+
+	if character.Concentration != "" {
+		dcStr += fmt.Sprintf("\nConcentrating on: %s", character.Concentration)
+	}
+
+	return dcStr
+}
+
 func GetSpellSlotContent(character models.Character, width int) string {
 	width = width - (widthPadding * 2) // padding on both sides
 	slotHeader := "Spell Slots"
@@ -83,11 +113,21 @@ func GetSpellSlotContent(character models.Character, width int) string {
 		slots := models.GetSlots(s.Available, s.Maximum)
 		level := strconv.FormatInt(int64(s.Level), 10)
 		slotLine := fmt.Sprintf("lvl: %s - %s", level, slots)
+		if s.Manual && s.Maximum != s.Expected {
+			slotLine += fmt.Sprintf(" (manual, expected %d)", s.Expected)
+		}
 		lineLength := utf8.RuneCountInString(slotLine)
 		maxLineWidth = max(lineLength, maxLineWidth)
 		slotLines = append(slotLines, slotLine)
 	}
 
+	for _, s := range character.GetPactMagicSlots() {
+		slots := models.GetSlots(s.Available, s.Maximum)
+		slotLine := fmt.Sprintf("pact lvl: %d - %s", s.Level, slots)
+		maxLineWidth = max(utf8.RuneCountInString(slotLine), maxLineWidth)
+		slotLines = append(slotLines, slotLine)
+	}
+
 	for _, line := range slotLines {
 		length := utf8.RuneCountInString(line)
 		slotContent += fmt.Sprintf("%s%s\n\n", line, strings.Repeat("\u00A0", maxLineWidth-length))
@@ -121,8 +161,7 @@ func (m SpellsModel) UpdateSize(innerWidth, availableHeight int, character model
 	m.KnownSpellsViewport.Width = knownSpellsInnerWidth
 
 	if !m.contentSet {
-		dcStr := fmt.Sprintf("Spell Save DC: %d", character.SpellSaveDC)
-		m.SpellSaveDCViewport.SetContent(dcStr)
+		m.SpellSaveDCViewport.SetContent(GetSpellSaveDCContent(character))
 
 		spellSlotsContent := GetSpellSlotContent(character, m.SpellSaveDCViewport.Width)
 		m.SpellSlotsViewport.SetContent(spellSlotsContent)
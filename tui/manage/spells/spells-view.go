@@ -6,6 +6,9 @@ import (
 
 const (
 	widthPadding int = 2
+	// " - DC/Attack", the column is dropped when it would leave less than minSpellNameWidth for names
+	statsColumnWidth  int = 12
+	minSpellNameWidth int = 12
 )
 
 const (
@@ -9,6 +9,7 @@ import (
 	"github.com/onioncall/dndgo/character-management/handlers"
 	"github.com/onioncall/dndgo/character-management/models"
 	"github.com/onioncall/dndgo/character-management/shared"
+	"github.com/onioncall/dndgo/dice"
 	"github.com/onioncall/dndgo/logger"
 	"github.com/onioncall/dndgo/tui/manage/class"
 	"github.com/onioncall/dndgo/tui/manage/equipment"
@@ -30,15 +31,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		m.height = msg.Height
 
 		if m.character != nil {
-			innerWidth, availableHeight := m.getInnerDimensions()
-			m.basicInfoTab = m.basicInfoTab.UpdateSize(innerWidth, availableHeight, *m.character)
-			if m.character.SpellSaveDC > 0 {
-				m.spellsTab = m.spellsTab.UpdateSize(innerWidth, availableHeight, *m.character)
-			}
-			m.equipmentTab = m.equipmentTab.UpdateSize(innerWidth, availableHeight, *m.character)
-			m.classTab = m.classTab.UpdateSize(innerWidth, availableHeight, m.currentClass, *m.character)
-			m.notesTab = m.notesTab.UpdateSize(innerWidth, availableHeight, *m.character)
-			m.helpTab = m.helpTab.UpdateSize(innerWidth, availableHeight, *m.character)
+			m = m.updateTabs()
 		}
 
 		return m, nil
@@ -54,12 +47,13 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			}
 			return m, tea.Quit
 		case "esc":
-			if m.visibleCmd != cmdInactive || m.err != nil {
+			if m.visibleCmd != cmdInactive || m.err != nil || m.result != "" {
 				if value, exists := m.keyBindings[m.visibleCmd]; exists {
 					value.input.Blur()
 				}
 
 				m.err = nil
+				m.result = ""
 				m.visibleCmd = cmdInactive
 
 				return m, nil
@@ -99,6 +93,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 			if value, exists := m.keyBindings[m.visibleCmd]; exists {
 				value.input.Blur()
+				m.result = ""
 				m = value.cmdFunc(m)
 				value.input.SetValue("")
 				m.visibleCmd = cmdInactive
@@ -159,6 +154,50 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+func (m Model) updateTabs() Model {
+	innerWidth, availableHeight := m.getInnerDimensions()
+	m.basicInfoTab = m.basicInfoTab.UpdateSize(innerWidth, availableHeight, *m.character)
+	if m.character.SpellSaveDC > 0 {
+		m.spellsTab = m.spellsTab.UpdateSize(innerWidth, availableHeight, *m.character)
+	}
+	m.equipmentTab = m.equipmentTab.UpdateSize(innerWidth, availableHeight, *m.character)
+	m.classTab = m.classTab.UpdateSize(innerWidth, availableHeight, m.currentClass, *m.character)
+	m.notesTab = m.notesTab.UpdateSize(innerWidth, availableHeight, *m.character)
+	m.helpTab = m.helpTab.UpdateSize(innerWidth, availableHeight, *m.character)
+
+	return m
+}
+
+// Leveling up changes almost every derived stat, so rather than recalculating pieces of the character we save
+// it and load it fresh
+func (m Model) reloadCharacter() (Model, error) {
+	err := handlers.SaveCharacter(m.character)
+	if err != nil {
+		return m, fmt.Errorf("Failed to save character:\n%w", err)
+	}
+
+	for i := range m.character.Classes {
+		err = handlers.SaveClass(m.character.Classes[i])
+		if err != nil {
+			return m, fmt.Errorf("Failed to save class '%s':\n%w", m.character.Classes[i].GetClassType(), err)
+		}
+	}
+
+	character, err := handlers.LoadCharacter()
+	if err != nil {
+		return m, fmt.Errorf("Failed to load character:\n%w", err)
+	}
+
+	err = handlers.HandleCharacter(character)
+	if err != nil {
+		return m, fmt.Errorf("Failed to process character:\n%w", err)
+	}
+
+	m.character = character
+
+	return m.updateTabs(), nil
+}
+
 func (m Model) executeUserCmd(cmdInput string, currentTab int) (Model, int, string) {
 	cmd, inputAfterCmd, _ := strings.Cut(cmdInput, " ")
 	tab := currentTab
@@ -177,21 +216,117 @@ func (m Model) executeUserCmd(cmdInput string, currentTab int) (Model, int, stri
 	case damageCmd:
 		dmg, err := strconv.Atoi(inputAfterCmd)
 		m.err = err
-		m.character.DamageCharacter(int(dmg))
-		m.basicInfoTab.HealthViewport.SetContent(info.GetHealthContent(*m.character))
+		m = execDamage(m, dmg)
 	case recoverCmd:
 		m.err = execRecoverCmd(inputAfterCmd, m.character)
-		m.basicInfoTab.HealthViewport.SetContent(info.GetHealthContent(*m.character))
+		m = refreshConditionViews(m)
 		m.classTab.DetailViewport.SetContent(class.GetClassDetails(m.currentClass, *m.character))
 		if m.character.SpellSaveDC > 0 {
 			sWidth := m.spellsTab.SpellSlotsViewport.Width
 			m.spellsTab.SpellSlotsViewport.SetContent(spells.GetSpellSlotContent(*m.character, sWidth))
 		}
+	case shortRestCmd:
+		m = execShortRest(m, inputAfterCmd)
+	case conditionCmd:
+		m.err = m.character.AddCondition(inputAfterCmd)
+		m = refreshConditionViews(m)
+	case removeConditionCmd:
+		if strings.EqualFold(strings.TrimSpace(inputAfterCmd), "all") {
+			m.character.ClearConditions()
+		} else {
+			m.err = m.character.RemoveCondition(inputAfterCmd)
+		}
+		m = refreshConditionViews(m)
+	case castCmd:
+		result, err := execCastCmd(inputAfterCmd, m.character)
+		m.err = err
+		m.result = result
+		m = refreshSpellViews(m)
+	case createSlotCmd, convertSlotCmd:
+		level, err := strconv.Atoi(inputAfterCmd)
+		if err != nil {
+			m.err = fmt.Errorf("Invalid argument '%s', slot level must be an integer", inputAfterCmd)
+			break
+		}
+
+		if cmd == createSlotCmd {
+			m.err = m.character.CreateSpellSlot(level)
+		} else {
+			m.err = m.character.ConvertSpellSlot(level)
+		}
+
+		if m.err == nil {
+			m.result = fmt.Sprintf("%d sorcery points left", m.character.GetSorceryPoints())
+		}
+		m = refreshSpellViews(m)
+		m.classTab.DetailViewport.SetContent(class.GetClassDetails(m.currentClass, *m.character))
+	case arcaneRecoveryCmd:
+		m.err = m.character.ArcaneRecovery(inputAfterCmd)
+		if m.err == nil {
+			m.result = "Spell slots recovered with Arcane Recovery"
+		}
+		m = refreshSpellViews(m)
+		m.classTab.DetailViewport.SetContent(class.GetClassDetails(m.currentClass, *m.character))
+	case concentrationSaveCmd:
+		result, err := execConcentrationSaveCmd(inputAfterCmd, m.character)
+		m.err = err
+		m.result = result
+		m = refreshSpellViews(m)
+	case dropConcentrationCmd:
+		m.character.DropConcentration()
+		m = refreshSpellViews(m)
+	case deathSaveCmd:
+		result, err := m.character.RollDeathSave()
+		m.err = err
+		if err == nil {
+			m.result = result.String()
+		}
+		m.basicInfoTab.HealthViewport.SetContent(info.GetHealthContent(*m.character))
 	case addTempCmd:
 		temp, err := strconv.Atoi(inputAfterCmd)
 		m.err = err
 		m.character.AddTempHp(int(temp))
 		m.basicInfoTab.HealthViewport.SetContent(info.GetHealthContent(*m.character))
+	case addXPCmd:
+		xp, err := strconv.Atoi(inputAfterCmd)
+		if err != nil {
+			m.err = fmt.Errorf("Invalid argument '%s', XP must be an integer", inputAfterCmd)
+			break
+		}
+
+		m.err = m.character.AddXP(xp)
+		if m.character.IsLevelUpAvailable() {
+			m.result = fmt.Sprintf("%s has enough XP to reach level %d", m.character.Name, m.character.GetLevelFromXP())
+		}
+		m.basicInfoTab.BasicStatsViewport.SetContent(info.GetStatsContent(*m.character))
+	case raceCmd:
+		race, subrace, _ := strings.Cut(inputAfterCmd, "/")
+		m.err = m.character.SetRace(strings.TrimSpace(race), strings.TrimSpace(subrace))
+		if m.err == nil {
+			m, m.err = m.reloadCharacter()
+		}
+	case racialBonusCmd:
+		mode, abilities, _ := strings.Cut(inputAfterCmd, "/")
+		choices := []string{}
+		if strings.TrimSpace(abilities) != "" {
+			choices = strings.Split(abilities, ",")
+		}
+
+		m.err = m.character.SetRacialBonuses(mode, choices)
+		if m.err == nil {
+			m, m.err = m.reloadCharacter()
+		}
+	case replaceProfCmd:
+		duplicate, replacement, ok := strings.Cut(inputAfterCmd, "/")
+		if !ok {
+			m.err = fmt.Errorf("Invalid argument '%s', use '%s <duplicate>/<replacement>'", inputAfterCmd, replaceProfCmd)
+			break
+		}
+
+		m.err = m.character.ReplaceBackgroundDuplicate(strings.TrimSpace(duplicate), strings.TrimSpace(replacement))
+		if m.err == nil {
+			m, m.err = m.reloadCharacter()
+		}
 	// TODO: Rename functionality will have to change with the support of multiple character files
 	// case renameCmd:
 	// 	if inputAfterCmd != "" {
@@ -206,9 +341,13 @@ func (m Model) executeUserCmd(cmdInput string, currentTab int) (Model, int, stri
 			break
 		}
 
-		level, err := strconv.Atoi(inputAfterCmd)
-		m.err = err
-		m.character.UseSpellSlot(int(level))
+		if strings.EqualFold(inputAfterCmd, pactSlotArg) {
+			m.err = m.character.UsePactMagicSlot(0)
+		} else {
+			level, err := strconv.Atoi(inputAfterCmd)
+			m.err = err
+			m.character.UseSpellSlot(int(level))
+		}
 		sWidth := m.spellsTab.SpellSlotsViewport.Width
 		m.spellsTab.SpellSlotsViewport.SetContent(spells.GetSpellSlotContent(*m.character, sWidth))
 	case recoverSlotCmd:
@@ -217,13 +356,21 @@ func (m Model) executeUserCmd(cmdInput string, currentTab int) (Model, int, stri
 			break
 		}
 
-		level, err := strconv.Atoi(inputAfterCmd)
-		m.err = err
-		m.character.RecoverSpellSlots(int(level), 1)
+		if strings.EqualFold(inputAfterCmd, pactSlotArg) {
+			m.character.RecoverPactMagicSlots(1)
+		} else {
+			level, err := strconv.Atoi(inputAfterCmd)
+			m.err = err
+			m.character.RecoverSpellSlots(int(level), 1)
+		}
 		sWidth := m.spellsTab.SpellSlotsViewport.Width
 		m.spellsTab.SpellSlotsViewport.SetContent(spells.GetSpellSlotContent(*m.character, sWidth))
 	case addEquipmentCmd:
 		m.err = execAddEquipmentCmd(inputAfterCmd, m.character)
+		m.result = m.character.GetLoadWarning()
+		bpWidth := m.equipmentTab.BackpackViewport.Width
+		m.equipmentTab.BackpackViewport.SetContent(equipment.GetBackpackContent(*m.character, bpWidth))
+		m = refreshConditionViews(m)
 		weWidth := m.equipmentTab.WornEquipmentViewport.Width
 		m.equipmentTab.WornEquipmentViewport.SetContent(equipment.GetWornEquipmentContent(*m.character, weWidth))
 	case equipCmd:
@@ -234,20 +381,55 @@ func (m Model) executeUserCmd(cmdInput string, currentTab int) (Model, int, stri
 		m.err = execUnequipCmd(inputAfterCmd, m.character)
 		wpWidth := m.equipmentTab.WeaponsViewport.Width
 		m.equipmentTab.WeaponsViewport.SetContent(equipment.GetWeaponsContent(*m.character, wpWidth))
+	case attuneCmd, unattuneCmd:
+		m.err = execAttuneCmd(strings.ToLower(cmd), inputAfterCmd, m.character)
+		if m.err == nil {
+			m, m.err = m.reloadCharacter()
+			m.result = fmt.Sprintf("Attuned to %s items", m.character.GetAttunement())
+		}
+	case attackCmd:
+		result, err := execAttackCmd(inputAfterCmd, m.character)
+		m.err = err
+		m.result = result
 	case addItemCmd:
 		m.err = execModifyItemCmd(inputAfterCmd, true, m.character)
+		m.result = m.character.GetLoadWarning()
 		bpWidth := m.equipmentTab.BackpackViewport.Width
 		m.equipmentTab.BackpackViewport.SetContent(equipment.GetBackpackContent(*m.character, bpWidth))
+		m = refreshConditionViews(m)
 	case removeItemCmd:
 		m.err = execModifyItemCmd(inputAfterCmd, false, m.character)
 		bpWidth := m.equipmentTab.BackpackViewport.Width
 		m.equipmentTab.BackpackViewport.SetContent(equipment.GetBackpackContent(*m.character, bpWidth))
+		m = refreshConditionViews(m)
+	case addMoneyCmd, spendMoneyCmd, convertMoneyCmd:
+		m.err = execPurseCmd(strings.ToLower(cmd), inputAfterCmd, m.character)
+		bpWidth := m.equipmentTab.BackpackViewport.Width
+		m.equipmentTab.BackpackViewport.SetContent(equipment.GetBackpackContent(*m.character, bpWidth))
 	case useClassTokenCmd:
 		m.err = execUseClassTokenCmd(inputAfterCmd, m.currentClass, m.character)
 		m.classTab.DetailViewport.SetContent(class.GetClassDetails(m.currentClass, *m.character))
 	case recoverClassTokenCmd:
 		m.err = execRecoverClassTokenCmd(inputAfterCmd, m.currentClass, m.character)
 		m.classTab.DetailViewport.SetContent(class.GetClassDetails(m.currentClass, *m.character))
+	case rollCmd:
+		result, err := dice.Roll(inputAfterCmd)
+		m.err = err
+		if err == nil {
+			m.result = result.String()
+		}
+	case levelUpCmd:
+		result, err := execLevelUpCmd(inputAfterCmd, m.currentClass, m.character)
+		m.err = err
+		if err == nil {
+			m, m.err = m.reloadCharacter()
+			m.result = result
+		}
+	case asiCmd, featCmd, subClassCmd, swapSpellCmd:
+		m.err = execLevelUpChoiceCmd(strings.ToLower(cmd), inputAfterCmd, m.currentClass, m.character)
+		if m.err == nil {
+			m, m.err = m.reloadCharacter()
+		}
 	case updateClassCmd:
 		classType, err := execValidateUpdateClass(m.currentClass, *m.character)
 		m.err = err
@@ -260,6 +442,188 @@ func (m Model) executeUserCmd(cmdInput string, currentTab int) (Model, int, stri
 	return m, tab, newInput
 }
 
+// Damage can end concentration, or call for a concentration save which we offer in the result box
+func execDamage(m Model, dmg int) Model {
+	m.character.DamageCharacter(dmg)
+	m.basicInfoTab.HealthViewport.SetContent(info.GetHealthContent(*m.character))
+	m = refreshSpellViews(m)
+
+	if m.character.ConcentrationSaveDC > 0 {
+		m.result = fmt.Sprintf("Concentrating on %s, constitution save DC %d\nRun '%s' to roll",
+			m.character.Concentration, m.character.ConcentrationSaveDC, concentrationSaveCmd)
+	}
+
+	return m
+}
+
+func refreshSpellViews(m Model) Model {
+	if m.character.SpellSaveDC == 0 {
+		return m
+	}
+
+	m.spellsTab.SpellSaveDCViewport.SetContent(spells.GetSpellSaveDCContent(*m.character))
+	sWidth := m.spellsTab.SpellSlotsViewport.Width
+	m.spellsTab.SpellSlotsViewport.SetContent(spells.GetSpellSlotContent(*m.character, sWidth))
+
+	return m
+}
+
+// Input is the spell name with an optional slot level and metamagic, ex: 'bless', 'bless/2', or 'bless//twinned'
+func execCastCmd(input string, character *models.Character) (string, error) {
+	if character.SpellSaveDC == 0 {
+		return "", fmt.Errorf("Character cannot use spell commands")
+	}
+
+	spellName, levelStr, _ := strings.Cut(input, "/")
+	levelStr, metamagic, _ := strings.Cut(levelStr, "/")
+	levelStr = strings.TrimSpace(levelStr)
+	metamagic = strings.TrimSpace(metamagic)
+	level := 0
+	pact := strings.EqualFold(levelStr, pactSlotArg)
+
+	if levelStr != "" && !pact {
+		var err error
+		level, err = strconv.Atoi(strings.TrimSpace(levelStr))
+		if err != nil {
+			return "", fmt.Errorf("Invalid argument '%s', slot level must be an integer or 'pact'", levelStr)
+		}
+	}
+
+	dropped, err := handlers.CastSpell(character, strings.TrimSpace(spellName), level, pact, metamagic)
+	if err != nil {
+		return "", err
+	}
+
+	result := fmt.Sprintf("Cast %s", strings.TrimSpace(spellName))
+	if metamagic != "" {
+		result += fmt.Sprintf(" with %s, %d sorcery points left", metamagic, character.GetSorceryPoints())
+	}
+	if dropped != "" {
+		result += fmt.Sprintf("\nConcentration on %s ended", dropped)
+	}
+
+	if character.Concentration != "" {
+		result += fmt.Sprintf("\nConcentrating on %s", character.Concentration)
+	}
+
+	return result, nil
+}
+
+func execConcentrationSaveCmd(input string, character *models.Character) (string, error) {
+	dc := 0
+	if strings.TrimSpace(input) != "" {
+		var err error
+		dc, err = strconv.Atoi(strings.TrimSpace(input))
+		if err != nil {
+			return "", fmt.Errorf("Invalid argument '%s', dc must be an integer", input)
+		}
+	}
+
+	result, err := character.RollConcentrationSave(dc)
+	if err != nil {
+		return "", err
+	}
+
+	return result.String(), nil
+}
+
+// Conditions touch speed, health and roll notes, so every basic info viewport is refreshed
+func refreshConditionViews(m Model) Model {
+	m.basicInfoTab.HealthViewport.SetContent(info.GetHealthContent(*m.character))
+	m.basicInfoTab.BasicStatsViewport.SetContent(info.GetStatsContent(*m.character))
+
+	aWidth := m.basicInfoTab.AbilitiesViewport.Width
+	m.basicInfoTab.AbilitiesViewport.SetContent(info.GetAbilitiesContent(*m.character, aWidth))
+
+	sWidth := m.basicInfoTab.SkillsViewport.Width
+	m.basicInfoTab.SkillsViewport.SetContent(info.GetSkillsContent(*m.character, sWidth))
+
+	return m
+}
+
+func execShortRest(m Model, spend string) Model {
+	results, err := m.character.ShortRest(strings.TrimSpace(spend))
+	m.err = err
+	if err != nil {
+		return m
+	}
+
+	rolls := []string{}
+	for _, result := range results {
+		rolls = append(rolls, result.String())
+	}
+	rolls = append(rolls, fmt.Sprintf("Short rest complete, HP: %d/%d", m.character.HPCurrent, m.character.HPMaxAdjusted))
+	m.result = strings.Join(rolls, "\n")
+
+	m.basicInfoTab.HealthViewport.SetContent(info.GetHealthContent(*m.character))
+	m.basicInfoTab.BasicStatsViewport.SetContent(info.GetStatsContent(*m.character))
+	m.classTab.DetailViewport.SetContent(class.GetClassDetails(m.currentClass, *m.character))
+	sWidth := m.spellsTab.SpellSlotsViewport.Width
+	m.spellsTab.SpellSlotsViewport.SetContent(spells.GetSpellSlotContent(*m.character, sWidth))
+
+	return m
+}
+
+// Input is (optional string, class)/(optional string, roll), the current class is leveled when none is given
+func execLevelUpCmd(input string, currentClass string, character *models.Character) (string, error) {
+	classType, hpMethod, _ := strings.Cut(input, "/")
+	classType = strings.TrimSpace(classType)
+	if classType == "" {
+		classType = currentClass
+	}
+
+	hpMethod = strings.ToLower(strings.TrimSpace(hpMethod))
+	if hpMethod != "" && hpMethod != "roll" && hpMethod != "average" {
+		return "", fmt.Errorf("Invalid argument '%s', hp is either 'roll' or 'average'", hpMethod)
+	}
+
+	result, err := handlers.LevelUp(character, classType, hpMethod == "roll")
+	if err != nil {
+		return "", err
+	}
+
+	s := result.String()
+	if result.AbilityScoreImprovement {
+		s += fmt.Sprintf("\n  use '%s <ability>' or '%s <ability>,<ability>' or '%s <name>/<ability>'", asiCmd, asiCmd, featCmd)
+	}
+
+	if result.SubClassChoice {
+		s += fmt.Sprintf("\n  use '%s <name>'", subClassCmd)
+	}
+
+	if result.SpellSwap {
+		s += fmt.Sprintf("\n  use '%s <known spell>/<new spell>'", swapSpellCmd)
+	}
+
+	return s, nil
+}
+
+func execLevelUpChoiceCmd(cmd string, input string, currentClass string, character *models.Character) error {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return fmt.Errorf("Invalid argument, %s requires a value", cmd)
+	}
+
+	switch cmd {
+	case asiCmd:
+		return character.ApplyAbilityScoreImprovement(strings.Split(input, ","))
+	case featCmd:
+		name, ability, _ := strings.Cut(input, "/")
+		return character.ChooseFeat(name, ability)
+	case subClassCmd:
+		return character.AddSubClass(currentClass, input)
+	case swapSpellCmd:
+		oldSpell, newSpell, found := strings.Cut(input, "/")
+		if !found || strings.TrimSpace(newSpell) == "" {
+			return fmt.Errorf("Invalid argument '%s', use '%s <known spell>/<new spell>'", input, swapSpellCmd)
+		}
+
+		return handlers.SwapSpell(character, strings.TrimSpace(oldSpell), strings.TrimSpace(newSpell), currentClass)
+	}
+
+	return nil
+}
+
 func execValidateUpdateClass(newClass string, character models.Character) (string, error) {
 	for _, class := range character.ClassTypes {
 		if strings.EqualFold(class, newClass) {
@@ -332,8 +696,14 @@ func execModifyItemCmd(input string, isAdd bool, character *models.Character) er
 	splitInput := strings.Split(input, "/")
 	itemName := input
 	quantity := 1
+	weightInput := []string{}
 	var err error
 
+	if len(splitInput) == 3 && isAdd {
+		weightInput = splitInput[2:]
+		splitInput = splitInput[:2]
+	}
+
 	if len(splitInput) == 2 {
 		quantity, err = strconv.Atoi(splitInput[1])
 		if err != nil {
@@ -342,11 +712,16 @@ func execModifyItemCmd(input string, isAdd bool, character *models.Character) er
 
 		itemName = splitInput[0]
 	} else if len(splitInput) != 1 {
-		return fmt.Errorf("Invalid argument, (string, item name)/(optional int, quantity)")
+		return fmt.Errorf("Invalid argument, (string, item name)/(optional int, quantity)/(optional number, weight)")
 	}
 
 	if isAdd {
-		character.AddItemToPack(itemName, quantity)
+		weight, err := parseOptionalWeight(weightInput, itemName)
+		if err != nil {
+			return err
+		}
+
+		character.AddItemToPack(itemName, quantity, weight)
 	} else {
 		err = character.RemoveItemFromPack(itemName, quantity)
 	}
@@ -354,6 +729,78 @@ func execModifyItemCmd(input string, isAdd bool, character *models.Character) er
 	return err
 }
 
+func execPurseCmd(cmd string, input string, character *models.Character) error {
+	// Conversion input is (string, coins)/(string, denomination), ex: 20sp/gp
+	amountStr, to, hasTo := strings.Cut(input, "/")
+	if cmd == convertMoneyCmd && !hasTo {
+		return fmt.Errorf("Invalid argument, (string, coins)/(string, denomination to convert to)")
+	}
+
+	amount, err := models.ParseCurrency(strings.TrimSpace(amountStr))
+	if err != nil {
+		return err
+	}
+
+	switch cmd {
+	case addMoneyCmd:
+		character.AddCurrency(amount)
+	case spendMoneyCmd:
+		err = character.SpendCurrency(amount)
+	case convertMoneyCmd:
+		for _, denomination := range shared.Currencies {
+			if coins := models.GetCurrencyAmount(amount, denomination); coins > 0 {
+				return character.ConvertCurrency(coins, denomination, strings.TrimSpace(to))
+			}
+		}
+	}
+
+	return err
+}
+
+func execAttackCmd(input string, character *models.Character) (string, error) {
+	// Input is (optional string, primary/secondary/weapon name)/(optional string, adv/dis), when no weapon
+	// is specified we attack with the primary weapon
+	splitInput := strings.Split(input, "/")
+	if len(splitInput) > 2 {
+		return "", fmt.Errorf("Invalid argument, (string, primary/secondary/weapon name)/(optional string, adv/dis)")
+	}
+
+	weaponName := strings.TrimSpace(splitInput[0])
+	advantage := false
+	disadvantage := false
+
+	if len(splitInput) == 2 {
+		switch strings.ToLower(strings.TrimSpace(splitInput[1])) {
+		case "adv":
+			advantage = true
+		case "dis":
+			disadvantage = true
+		default:
+			return "", fmt.Errorf("Invalid argument '%s', (string, primary/secondary/weapon name)/(optional string, adv/dis)",
+				splitInput[1])
+		}
+	}
+
+	var err error
+	switch strings.ToLower(weaponName) {
+	case "", "primary":
+		weaponName, err = character.GetEquippedWeapon(true)
+	case "secondary":
+		weaponName, err = character.GetEquippedWeapon(false)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	result, err := character.Attack(weaponName, advantage, disadvantage)
+	if err != nil {
+		return "", err
+	}
+
+	return result.String(), nil
+}
+
 func execUnequipCmd(input string, character *models.Character) error {
 	// We're going to let the user optionally specify if they want to equip as primary or secondary.
 	// If they don't specify, we'll equip the open slot. If no spot is open, we are going to equip primary
@@ -373,6 +820,19 @@ func execUnequipCmd(input string, character *models.Character) error {
 	return nil
 }
 
+func execAttuneCmd(cmd string, input string, character *models.Character) error {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return fmt.Errorf("Too few arguments, (string, item name)")
+	}
+
+	if cmd == unattuneCmd {
+		return character.Unattune(input)
+	}
+
+	return character.Attune(input)
+}
+
 func execEquipCmd(input string, character *models.Character) error {
 	// We're going to let the user optionally specify if they want to equip as primary or secondary.
 	// If they don't specify, we'll equip the open slot. If no spot is open, we are going to equip primary
@@ -407,8 +867,8 @@ func execAddEquipmentCmd(input string, character *models.Character) error {
 	splitInput := strings.Split(input, "/")
 	if len(splitInput) < 2 {
 		return fmt.Errorf("Too few arguments, (string, equipment type)/(string, equipment name)")
-	} else if len(splitInput) > 2 {
-		return fmt.Errorf("Too many arguments, (string, equipment type)/(string, equipment name)")
+	} else if len(splitInput) > 3 {
+		return fmt.Errorf("Too many arguments, (string, equipment type)/(string, equipment name)/(optional number, weight)")
 	}
 
 	inputEquipmentType := splitInput[0]
@@ -416,7 +876,12 @@ func execAddEquipmentCmd(input string, character *models.Character) error {
 
 	for _, wornEquipmentType := range shared.WornEquipmentTypes {
 		if strings.ToLower(inputEquipmentType) == wornEquipmentType {
-			character.AddEquipment(wornEquipmentType, inputEquipmentName)
+			weight, err := parseOptionalWeight(splitInput[2:], inputEquipmentName)
+			if err != nil {
+				return err
+			}
+
+			character.AddEquipment(wornEquipmentType, inputEquipmentName, weight)
 			return nil
 		}
 	}
@@ -424,6 +889,20 @@ func execAddEquipmentCmd(input string, character *models.Character) error {
 	return fmt.Errorf("Equipment type '%s', not found", inputEquipmentType)
 }
 
+// Weight is an optional argument, when it isn't given we look the item up in the SRD
+func parseOptionalWeight(input []string, itemName string) (float64, error) {
+	if len(input) == 0 {
+		return handlers.GetEquipmentWeight(itemName), nil
+	}
+
+	weight, err := strconv.ParseFloat(strings.TrimSpace(input[0]), 64)
+	if err != nil || weight < 0 {
+		return 0, fmt.Errorf("Invalid argument '%s', weight must be a positive number", input[0])
+	}
+
+	return weight, nil
+}
+
 // KeyBind Functions
 
 func ExecPaletteKeyBinding(m Model) Model {
@@ -456,10 +935,8 @@ func ExecNavKeyBinding(m Model) Model {
 func ExecDamageKeyBinding(m Model) Model {
 	dmg, err := strconv.Atoi(m.keyBindings[damageKeybinding].input.Value())
 	m.err = err
-	m.character.DamageCharacter(int(dmg))
-	m.basicInfoTab.HealthViewport.SetContent(info.GetHealthContent(*m.character))
 
-	return m
+	return execDamage(m, dmg)
 }
 
 func ExecRecoverKeyBinding(m Model) Model {
@@ -476,7 +953,7 @@ func ExecLongRestKeyBinding(m Model) Model {
 	if confirmLongRest == "yes" || confirmLongRest == "y" {
 
 		m.character.Recover()
-		m.basicInfoTab.HealthViewport.SetContent(info.GetHealthContent(*m.character))
+		m = refreshConditionViews(m)
 		m.classTab.DetailViewport.SetContent(class.GetClassDetails(m.currentClass, *m.character))
 		sWidth := m.spellsTab.SpellSlotsViewport.Width
 		m.spellsTab.SpellSlotsViewport.SetContent(spells.GetSpellSlotContent(*m.character, sWidth))
@@ -485,16 +962,33 @@ func ExecLongRestKeyBinding(m Model) Model {
 	return m
 }
 
+func ExecShortRestKeyBinding(m Model) Model {
+	return execShortRest(m, m.keyBindings[shortRestKeybinding].input.Value())
+}
+
 func ExecUseSpellKeyBinding(m Model) Model {
-	level, err := strconv.Atoi(m.keyBindings[useSpellKeybinding].input.Value())
-	m.err = err
-	m.character.UseSpellSlot(int(level))
+	input := m.keyBindings[useSpellKeybinding].input.Value()
+	if strings.EqualFold(input, pactSlotArg) {
+		m.err = m.character.UsePactMagicSlot(0)
+	} else {
+		level, err := strconv.Atoi(input)
+		m.err = err
+		m.character.UseSpellSlot(int(level))
+	}
 	sWidth := m.spellsTab.SpellSlotsViewport.Width
 	m.spellsTab.SpellSlotsViewport.SetContent(spells.GetSpellSlotContent(*m.character, sWidth))
 
 	return m
 }
 
+func ExecAttackKeyBinding(m Model) Model {
+	result, err := execAttackCmd(m.keyBindings[attackKeybinding].input.Value(), m.character)
+	m.err = err
+	m.result = result
+
+	return m
+}
+
 func ExecUseClassTokenKeyBinding(m Model) Model {
 	tokenName := m.keyBindings[useClassTokenKeybinding].input.Value()
 	m.character.UseClassTokens(tokenName, m.currentClass, 1)
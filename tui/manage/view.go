@@ -61,10 +61,7 @@ func (m Model) View() string {
 	}
 
 	outerBorderMargin := 2
-	bottomBoxHeight := 0
-	if m.visibleCmd != cmdInactive || m.err != nil {
-		bottomBoxHeight = 3
-	}
+	bottomBoxHeight := m.getBottomBoxHeight()
 
 	containerStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -108,6 +105,11 @@ func (m Model) View() string {
 		return lipgloss.JoinVertical(lipgloss.Left, container, cmdBox)
 	}
 
+	if m.result != "" {
+		resultBox := m.renderResultBox()
+		return lipgloss.JoinVertical(lipgloss.Left, container, resultBox)
+	}
+
 	return container
 }
 
@@ -266,6 +268,22 @@ func (m Model) renderErrorBox() string {
 		Render(errorBox)
 }
 
+func (m Model) renderResultBox() string {
+	resultStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(orange).
+		Padding(0, 1).
+		Foreground(cream).
+		MaxWidth(m.width).
+		Width(60)
+	resultBox := resultStyle.Render(m.result)
+
+	return lipgloss.NewStyle().
+		Width(m.width).
+		Align(lipgloss.Center).
+		Render(resultBox)
+}
+
 func (m Model) renderNoCharacter() string {
 	noCharacterStyle := lipgloss.NewStyle().
 		Padding(0, 1).
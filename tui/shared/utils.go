@@ -9,6 +9,11 @@ func TruncateString(s string, length int) string {
 		return s
 	}
 
+	// too short for an elipses, so we just cut it off
+	if length <= 3 {
+		return string(runes[:max(length, 0)])
+	}
+
 	truncatedRunes := runes[:length-3]
 
 	return string(truncatedRunes) + "..."